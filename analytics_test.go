@@ -0,0 +1,80 @@
+package vortex
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestGetInvitationTimeSeries(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v1/analytics/timeseries" {
+			t.Errorf("Expected path /api/v1/analytics/timeseries, got %s", r.URL.Path)
+		}
+
+		query := r.URL.Query()
+		if query.Get("metric") != "accepts" || query.Get("interval") != "week" {
+			t.Errorf("Expected weekly accepts, got %s", r.URL.RawQuery)
+		}
+		if query.Get("from") != "2024-01-01T00:00:00Z" || query.Get("groupId") != "ws-123" {
+			t.Errorf("Expected from and group filters, got %s", r.URL.RawQuery)
+		}
+		if _, ok := query["to"]; ok {
+			t.Error("Expected unset filters to be omitted")
+		}
+
+		w.Write([]byte(`{"buckets": [
+			{"start": "2024-01-01T00:00:00Z", "count": 12},
+			{"start": "2024-01-08T00:00:00Z", "count": 18}
+		]}`))
+	}))
+	defer server.Close()
+
+	client := NewClientWithOptions("test-api-key", server.URL, nil)
+
+	buckets, err := client.GetInvitationTimeSeries(context.Background(), MetricAccepts, IntervalWeek, TimeSeriesFilters{
+		From:  time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+		Group: &GroupRef{Type: "workspace", GroupID: "ws-123"},
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if len(buckets) != 2 || buckets[1].Count != 18 {
+		t.Errorf("Unexpected buckets %+v", buckets)
+	}
+}
+
+func TestGetInvitationStats(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v1/analytics/stats" {
+			t.Errorf("Expected /api/v1/analytics/stats, got %s", r.URL.Path)
+		}
+		query := r.URL.Query()
+		if query.Get("from") != "2024-01-01T00:00:00Z" || query.Get("to") != "" {
+			t.Errorf("Unexpected date range %s", r.URL.RawQuery)
+		}
+		if query.Get("groupType") != "workspace" || query.Get("groupId") != "ws-123" {
+			t.Errorf("Unexpected group %s", r.URL.RawQuery)
+		}
+		w.Write([]byte(`{"total": 40, "byStatus": {"pending": 30, "accepted": 10}, "views": 25, "clickThroughs": 15, "accepted": 10, "acceptanceRate": 0.25}`))
+	}))
+	defer server.Close()
+
+	client := NewClientWithOptions("test-api-key", server.URL, nil)
+
+	stats, err := client.GetInvitationStats(context.Background(), StatsOptions{
+		From:  time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+		Group: &GroupRef{Type: "workspace", GroupID: "ws-123"},
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if stats.Total != 40 || stats.ByStatus[StatusPending] != 30 || stats.ClickThroughs != 15 {
+		t.Errorf("Unexpected stats %+v", stats)
+	}
+	if stats.AcceptanceRate != 0.25 {
+		t.Errorf("Expected acceptance rate 0.25, got %v", stats.AcceptanceRate)
+	}
+}
@@ -0,0 +1,44 @@
+package vortex
+
+import (
+	"context"
+	"fmt"
+)
+
+// ApproveInvitation approves an invitation held for admin review, which sends
+// it
+func (c *Client) ApproveInvitation(ctx context.Context, invitationID string, reviewer Actor, opts ...RequestOption) (*InvitationResult, error) {
+	path := fmt.Sprintf("/api/v1/invitations/%s/approve", invitationID)
+	return c.reviewInvitation(ctx, invitationID, path, reviewer, opts)
+}
+
+// RejectInvitation rejects an invitation held for admin review, so it is never
+// sent
+//
+// Example:
+//
+//	invitation, err := client.RejectInvitation(ctx, "invitation-id", vortex.Actor{ID: admin.ID, Email: admin.Email})
+func (c *Client) RejectInvitation(ctx context.Context, invitationID string, reviewer Actor, opts ...RequestOption) (*InvitationResult, error) {
+	path := fmt.Sprintf("/api/v1/invitations/%s/reject", invitationID)
+	return c.reviewInvitation(ctx, invitationID, path, reviewer, opts)
+}
+
+func (c *Client) reviewInvitation(ctx context.Context, invitationID, path string, reviewer Actor, opts []RequestOption) (*InvitationResult, error) {
+	requestBody := map[string]Actor{
+		"reviewer": reviewer,
+	}
+
+	responseBody, err := c.apiRequestContext(ctx, "POST", path, requestBody, nil, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	c.invalidateInvitation(invitationID)
+
+	var result InvitationResult
+	if err := c.decode(responseBody, &result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	return &result, nil
+}
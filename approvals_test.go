@@ -0,0 +1,53 @@
+package vortex
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestApproveAndRejectInvitation(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req map[string]Actor
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Errorf("Failed to decode request body: %v", err)
+		}
+		if req["reviewer"].ID != "admin-1" {
+			t.Errorf("Expected reviewer 'admin-1', got %+v", req["reviewer"])
+		}
+
+		var status InvitationStatus
+		switch r.URL.Path {
+		case "/api/v1/invitations/inv1/approve":
+			status = "pending"
+		case "/api/v1/invitations/inv2/reject":
+			status = StatusRejected
+		default:
+			t.Errorf("Unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		json.NewEncoder(w).Encode(InvitationResult{Status: status})
+	}))
+	defer server.Close()
+
+	client := NewClientWithOptions("test-api-key", server.URL, nil)
+	ctx := context.Background()
+	reviewer := Actor{ID: "admin-1"}
+
+	result, err := client.ApproveInvitation(ctx, "inv1", reviewer)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if result.Status != "pending" {
+		t.Errorf("Expected approved invitation to be pending, got %s", result.Status)
+	}
+
+	result, err = client.RejectInvitation(ctx, "inv2", reviewer)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if result.Status != StatusRejected {
+		t.Errorf("Expected status 'rejected', got %s", result.Status)
+	}
+}
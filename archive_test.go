@@ -0,0 +1,77 @@
+package vortex
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestArchiveAndRestoreInvitation(t *testing.T) {
+	archived := false
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == "POST" && r.URL.Path == "/api/v1/invitations/inv1/archive":
+			archived = true
+		case r.Method == "POST" && r.URL.Path == "/api/v1/invitations/inv1/restore":
+			archived = false
+		case r.Method == "GET" && r.URL.Path == "/api/v1/invitations":
+			if archived && r.URL.Query().Get("includeArchived") != "true" {
+				w.Write([]byte(`{"invitations": []}`))
+				return
+			}
+			json.NewEncoder(w).Encode(InvitationsResponse{Invitations: []InvitationResult{{ID: "inv1", Archived: archived}}})
+			return
+		default:
+			t.Errorf("Unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		json.NewEncoder(w).Encode(InvitationResult{
+			ID:       "inv1",
+			Archived: archived,
+			Target:   []InvitationTarget{{Type: "email", Value: "test@example.com"}},
+		})
+	}))
+	defer server.Close()
+
+	client := NewClientWithOptions("test-api-key", server.URL, nil, WithCache(NewLRUCache(10, time.Minute)))
+	ctx := context.Background()
+
+	result, err := client.ArchiveInvitation(ctx, "inv1")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if !result.Archived {
+		t.Error("Expected invitation to be archived")
+	}
+
+	invitations, err := client.GetInvitationsByTarget("email", "test@example.com")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if len(invitations) != 0 {
+		t.Errorf("Expected archived invitation to be hidden, got %d", len(invitations))
+	}
+
+	invitations, err = client.GetInvitationsByTarget("email", "test@example.com", IncludeArchived())
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if len(invitations) != 1 || !invitations[0].Archived {
+		t.Errorf("Expected the archived invitation, got %+v", invitations)
+	}
+
+	if _, err := client.RestoreInvitation(ctx, "inv1"); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	// The cached empty list must not hide the restored invitation
+	invitations, err = client.GetInvitationsByTarget("email", "test@example.com")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if len(invitations) != 1 {
+		t.Errorf("Expected restored invitation to be listed, got %d", len(invitations))
+	}
+}
@@ -0,0 +1,198 @@
+package vortex
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"net/textproto"
+	"path/filepath"
+	"time"
+)
+
+// DefaultMaxAttachmentSize is the largest file AttachFileToInvitation uploads
+// unless MaxAttachmentSize says otherwise
+const DefaultMaxAttachmentSize = 10 << 20
+
+// DefaultAttachmentTypes are the media types AttachFileToInvitation accepts
+// unless AllowedAttachmentTypes says otherwise
+var DefaultAttachmentTypes = []string{
+	"application/pdf",
+	"application/vnd.openxmlformats-officedocument.wordprocessingml.document",
+	"image/png",
+	"image/jpeg",
+	"text/plain",
+}
+
+var (
+	// ErrAttachmentTooLarge is returned for files over the size limit
+	ErrAttachmentTooLarge = errors.New("vortex: attachment too large")
+	// ErrAttachmentType is returned for files of a type that is not allowed
+	ErrAttachmentType = errors.New("vortex: attachment type not allowed")
+)
+
+// Attachment is a file included with an invitation
+type Attachment struct {
+	ID          string    `json:"id"`
+	Filename    string    `json:"filename"`
+	ContentType string    `json:"contentType"`
+	Size        int64     `json:"size"`
+	URL         string    `json:"url"`
+	CreatedAt   time.Time `json:"createdAt"`
+}
+
+// AttachmentOption configures an upload
+type AttachmentOption func(*attachmentOptions)
+
+type attachmentOptions struct {
+	maxSize      int64
+	allowedTypes []string
+	onProgress   func(sent, total int64)
+}
+
+// MaxAttachmentSize changes the size limit for an upload
+func MaxAttachmentSize(bytes int64) AttachmentOption {
+	return func(o *attachmentOptions) {
+		o.maxSize = bytes
+	}
+}
+
+// AllowedAttachmentTypes changes the media types an upload may have
+func AllowedAttachmentTypes(mediaTypes ...string) AttachmentOption {
+	return func(o *attachmentOptions) {
+		o.allowedTypes = mediaTypes
+	}
+}
+
+// OnUploadProgress calls fn as the upload is sent, with the bytes sent so far
+// and the total request size
+func OnUploadProgress(fn func(sent, total int64)) AttachmentOption {
+	return func(o *attachmentOptions) {
+		o.onProgress = fn
+	}
+}
+
+// AttachFileToInvitation uploads a document, such as an NDA or onboarding
+// PDF, to be included with an invitation. The file is checked against the
+// size limit and allowed types before anything is sent; its type comes from
+// the filename's extension, or from its content if the extension is unknown.
+//
+// Example:
+//
+//	f, err := os.Open("nda.pdf")
+//	if err != nil {
+//	    return err
+//	}
+//	defer f.Close()
+//	attachment, err := client.AttachFileToInvitation(ctx, invitationID, "nda.pdf", f,
+//	    vortex.OnUploadProgress(func(sent, total int64) {
+//	        fmt.Printf("\r%d/%d bytes", sent, total)
+//	    }))
+func (c *Client) AttachFileToInvitation(ctx context.Context, invitationID, filename string, r io.Reader, opts ...AttachmentOption) (*Attachment, error) {
+	options := attachmentOptions{
+		maxSize:      DefaultMaxAttachmentSize,
+		allowedTypes: DefaultAttachmentTypes,
+	}
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	content, err := io.ReadAll(io.LimitReader(r, options.maxSize+1))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read attachment: %w", err)
+	}
+	if int64(len(content)) > options.maxSize {
+		return nil, fmt.Errorf("%w: %s is over %d bytes", ErrAttachmentTooLarge, filename, options.maxSize)
+	}
+
+	mediaType := attachmentType(filename, content)
+	if !containsKey(options.allowedTypes, mediaType) {
+		return nil, fmt.Errorf("%w: %s is %s", ErrAttachmentType, filename, mediaType)
+	}
+
+	var buf bytes.Buffer
+	writer := multipart.NewWriter(&buf)
+	header := make(textproto.MIMEHeader)
+	header.Set("Content-Disposition", mime.FormatMediaType("form-data", map[string]string{"name": "file", "filename": filename}))
+	header.Set("Content-Type", mediaType)
+	part, err := writer.CreatePart(header)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode attachment: %w", err)
+	}
+	if _, err := part.Write(content); err != nil {
+		return nil, fmt.Errorf("failed to encode attachment: %w", err)
+	}
+	if err := writer.Close(); err != nil {
+		return nil, fmt.Errorf("failed to encode attachment: %w", err)
+	}
+
+	body := &rawBody{
+		mediaType:  writer.FormDataContentType(),
+		data:       buf.Bytes(),
+		onProgress: options.onProgress,
+	}
+
+	path := fmt.Sprintf("/api/v1/invitations/%s/attachments", invitationID)
+	responseBody, err := c.apiRequestContext(ctx, "POST", path, body, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var attachment Attachment
+	if err := c.decode(responseBody, &attachment); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	c.invalidateInvitation(invitationID)
+	return &attachment, nil
+}
+
+func attachmentType(filename string, content []byte) string {
+	detected := mime.TypeByExtension(filepath.Ext(filename))
+	if detected == "" {
+		detected = http.DetectContentType(content)
+	}
+	mediaType, _, err := mime.ParseMediaType(detected)
+	if err != nil {
+		return detected
+	}
+	return mediaType
+}
+
+// rawBody is a request body sent as-is rather than encoded as JSON
+type rawBody struct {
+	mediaType  string
+	data       []byte
+	onProgress func(sent, total int64)
+}
+
+func (b *rawBody) contentType() string {
+	return b.mediaType
+}
+
+func (b *rawBody) reader() io.Reader {
+	if b.onProgress == nil {
+		return bytes.NewReader(b.data)
+	}
+	return &progressReader{r: bytes.NewReader(b.data), total: int64(len(b.data)), onProgress: b.onProgress}
+}
+
+type progressReader struct {
+	r          io.Reader
+	sent       int64
+	total      int64
+	onProgress func(sent, total int64)
+}
+
+func (p *progressReader) Read(b []byte) (int, error) {
+	n, err := p.r.Read(b)
+	if n > 0 {
+		p.sent += int64(n)
+		p.onProgress(p.sent, p.total)
+	}
+	return n, err
+}
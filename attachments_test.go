@@ -0,0 +1,79 @@
+package vortex
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestAttachFileToInvitation(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "POST" || r.URL.Path != "/api/v1/invitations/inv-123/attachments" {
+			t.Errorf("Expected POST /api/v1/invitations/inv-123/attachments, got %s %s", r.Method, r.URL.Path)
+		}
+
+		file, header, err := r.FormFile("file")
+		if err != nil {
+			t.Fatalf("Failed to read uploaded file: %v", err)
+		}
+		content, _ := io.ReadAll(file)
+		if string(content) != "%PDF-1.7 nda" {
+			t.Errorf("Expected file content to be uploaded, got %q", content)
+		}
+		if header.Filename != "nda.pdf" {
+			t.Errorf("Expected filename nda.pdf, got %s", header.Filename)
+		}
+		if got := header.Header.Get("Content-Type"); got != "application/pdf" {
+			t.Errorf("Expected part type application/pdf, got %s", got)
+		}
+
+		w.Write([]byte(`{"id": "att-1", "filename": "nda.pdf", "contentType": "application/pdf", "size": 12}`))
+	}))
+	defer server.Close()
+
+	client := NewClientWithOptions("test-api-key", server.URL, nil)
+
+	var sent, total int64
+	attachment, err := client.AttachFileToInvitation(context.Background(), "inv-123", "nda.pdf", strings.NewReader("%PDF-1.7 nda"),
+		OnUploadProgress(func(s, t int64) {
+			sent, total = s, t
+		}))
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if attachment.ID != "att-1" {
+		t.Errorf("Expected attachment ID att-1, got %s", attachment.ID)
+	}
+	if total == 0 || sent != total {
+		t.Errorf("Expected progress to reach the total, got %d/%d", sent, total)
+	}
+}
+
+func TestAttachFileValidation(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Error("Expected invalid attachments not to be uploaded")
+	}))
+	defer server.Close()
+
+	client := NewClientWithOptions("test-api-key", server.URL, nil)
+	ctx := context.Background()
+
+	_, err := client.AttachFileToInvitation(ctx, "inv-123", "big.pdf", strings.NewReader(strings.Repeat("x", 11)), MaxAttachmentSize(10))
+	if !errors.Is(err, ErrAttachmentTooLarge) {
+		t.Errorf("Expected ErrAttachmentTooLarge, got %v", err)
+	}
+
+	_, err = client.AttachFileToInvitation(ctx, "inv-123", "setup.exe", strings.NewReader("MZ"))
+	if !errors.Is(err, ErrAttachmentType) {
+		t.Errorf("Expected ErrAttachmentType, got %v", err)
+	}
+
+	_, err = client.AttachFileToInvitation(ctx, "inv-123", "notes.txt", strings.NewReader("hello"), AllowedAttachmentTypes("application/pdf"))
+	if !errors.Is(err, ErrAttachmentType) {
+		t.Errorf("Expected ErrAttachmentType, got %v", err)
+	}
+}
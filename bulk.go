@@ -0,0 +1,180 @@
+package vortex
+
+import (
+	"context"
+	"fmt"
+	"sync"
+)
+
+// BulkConcurrency is how many requests RevokeInvitations, ReinviteMany and
+// AcceptInvitationsBatch send at once; WithMaxConcurrentRequests lowers it
+// further
+const BulkConcurrency = 8
+
+// BulkResult reports which invitations a bulk operation processed
+type BulkResult struct {
+	// Succeeded are the IDs that were processed, in the order given
+	Succeeded []string
+	// Failed maps the IDs that were not processed to their errors
+	Failed map[string]error
+}
+
+// BulkError is returned by bulk operations when any invitation failed; the
+// successful ones have still been processed
+type BulkError struct {
+	Result *BulkResult
+	// first is the error of the first ID to fail, in the order given
+	first error
+}
+
+func (e *BulkError) Error() string {
+	total := len(e.Result.Succeeded) + len(e.Result.Failed)
+	return fmt.Sprintf("vortex: %d of %d invitations failed: %v", len(e.Result.Failed), total, e.first)
+}
+
+// Unwrap returns the first failure, so errors.Is(err, ErrNotFound) and the
+// like match it
+func (e *BulkError) Unwrap() error {
+	return e.first
+}
+
+// RevokeInvitations revokes invitations concurrently, returning which
+// succeeded and which failed
+//
+// One failure does not stop the others; the error is a *BulkError when any
+// failed. Once ctx is done, the remaining invitations fail with its error.
+//
+// Example:
+//
+//	result, err := client.RevokeInvitations(ctx, staleIDs)
+//	for id, err := range result.Failed {
+//	    log.Printf("could not revoke %s: %v", id, err)
+//	}
+func (c *Client) RevokeInvitations(ctx context.Context, invitationIDs []string, opts ...RequestOption) (*BulkResult, error) {
+	return c.bulk(ctx, invitationIDs, func(invitationID string) error {
+		return c.RevokeInvitationContext(ctx, invitationID, opts...)
+	})
+}
+
+// ReinviteMany resends invitations concurrently, like RevokeInvitations
+//
+// Each resend carries its own idempotency key. An IdempotencyKey given in
+// opts is suffixed with "/" and the invitation ID, so calling again with the
+// same key after a partial failure only resends the ones that failed.
+func (c *Client) ReinviteMany(ctx context.Context, invitationIDs []string, opts ...RequestOption) (*BulkResult, error) {
+	optsFor := perInvitationOptions(ctx, opts)
+	return c.bulk(ctx, invitationIDs, func(invitationID string) error {
+		_, err := c.ReinviteContext(ctx, invitationID, optsFor(invitationID)...)
+		return err
+	})
+}
+
+// AcceptResult is the outcome of accepting one invitation in
+// AcceptInvitationsBatch
+type AcceptResult struct {
+	InvitationID string
+	// Invitation is the accepted invitation, or nil if Err is set
+	Invitation *InvitationResult
+	Err        error
+}
+
+// AcceptInvitationsBatch accepts invitations for target concurrently, one
+// request per invitation, reporting the outcome of each in the order given
+//
+// Unlike AcceptInvitations, which accepts all the invitations in one request,
+// a failure only affects its own invitation, so the failed ones can be
+// retried alone. The error is a *BulkError when any failed. An IdempotencyKey
+// given in opts is suffixed like in ReinviteMany.
+//
+// Example:
+//
+//	results, err := client.AcceptInvitationsBatch(ctx, invitationIDs, target)
+//	for _, result := range results {
+//	    if result.Err != nil {
+//	        retry = append(retry, result.InvitationID)
+//	    }
+//	}
+func (c *Client) AcceptInvitationsBatch(ctx context.Context, invitationIDs []string, target InvitationTarget, opts ...RequestOption) ([]AcceptResult, error) {
+	optsFor := perInvitationOptions(ctx, opts)
+	results := make([]AcceptResult, len(invitationIDs))
+	errs := forEach(ctx, invitationIDs, func(i int, invitationID string) error {
+		invitation, err := c.AcceptInvitationsContext(ctx, []string{invitationID}, target, optsFor(invitationID)...)
+		results[i].Invitation = invitation
+		return err
+	})
+
+	for i, invitationID := range invitationIDs {
+		results[i].InvitationID = invitationID
+		results[i].Err = errs[i]
+	}
+	return results, newBulkResult(invitationIDs, errs).err(errs)
+}
+
+// perInvitationOptions returns the options for each call of a bulk operation,
+// giving each its own idempotency key derived from the one in opts
+func perInvitationOptions(ctx context.Context, opts []RequestOption) func(invitationID string) []RequestOption {
+	key := resolveRequestOptions(withContextOptions(ctx, opts)).header.Get("Idempotency-Key")
+	return func(invitationID string) []RequestOption {
+		if key == "" {
+			return opts
+		}
+		return append(opts[:len(opts):len(opts)], IdempotencyKey(key+"/"+invitationID))
+	}
+}
+
+// bulk calls do for each ID with at most BulkConcurrency calls at once
+func (c *Client) bulk(ctx context.Context, invitationIDs []string, do func(invitationID string) error) (*BulkResult, error) {
+	errs := forEach(ctx, invitationIDs, func(_ int, invitationID string) error {
+		return do(invitationID)
+	})
+	result := newBulkResult(invitationIDs, errs)
+	return result, result.err(errs)
+}
+
+// forEach calls do for each ID with at most BulkConcurrency calls at once,
+// returning their errors by index; IDs not started before ctx is done get
+// its error
+func forEach(ctx context.Context, invitationIDs []string, do func(i int, invitationID string) error) []error {
+	errs := make([]error, len(invitationIDs))
+	slots := make(chan struct{}, BulkConcurrency)
+	var wg sync.WaitGroup
+	for i, invitationID := range invitationIDs {
+		select {
+		case slots <- struct{}{}:
+		case <-ctx.Done():
+			errs[i] = ctx.Err()
+			continue
+		}
+		wg.Add(1)
+		go func(i int, invitationID string) {
+			defer wg.Done()
+			defer func() { <-slots }()
+			errs[i] = do(i, invitationID)
+		}(i, invitationID)
+	}
+	wg.Wait()
+	return errs
+}
+
+// newBulkResult sorts invitationIDs by whether their call failed
+func newBulkResult(invitationIDs []string, errs []error) *BulkResult {
+	result := &BulkResult{Failed: make(map[string]error)}
+	for i, invitationID := range invitationIDs {
+		if errs[i] == nil {
+			result.Succeeded = append(result.Succeeded, invitationID)
+			continue
+		}
+		result.Failed[invitationID] = errs[i]
+	}
+	return result
+}
+
+// err returns a *BulkError if any call failed, or nil
+func (r *BulkResult) err(errs []error) error {
+	for _, err := range errs {
+		if err != nil {
+			return &BulkError{Result: r, first: err}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,161 @@
+package vortex
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestRevokeInvitations(t *testing.T) {
+	var inFlight, maxInFlight int32
+	var mu sync.Mutex
+	revoked := make(map[string]bool)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := atomic.AddInt32(&inFlight, 1)
+		defer atomic.AddInt32(&inFlight, -1)
+		for {
+			max := atomic.LoadInt32(&maxInFlight)
+			if n <= max || atomic.CompareAndSwapInt32(&maxInFlight, max, n) {
+				break
+			}
+		}
+		time.Sleep(5 * time.Millisecond)
+
+		if r.Method != "DELETE" {
+			t.Errorf("Expected DELETE, got %s", r.Method)
+		}
+		id := strings.TrimPrefix(r.URL.Path, "/api/v1/invitations/")
+		if id == "missing" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		mu.Lock()
+		revoked[id] = true
+		mu.Unlock()
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	client := NewClientWithOptions("test-api-key", server.URL, nil)
+
+	ids := []string{"inv-1", "missing"}
+	for i := 2; i <= 20; i++ {
+		ids = append(ids, fmt.Sprintf("inv-%d", i))
+	}
+	result, err := client.RevokeInvitations(context.Background(), ids)
+
+	var bulkErr *BulkError
+	if !errors.As(err, &bulkErr) || !errors.Is(err, ErrNotFound) {
+		t.Fatalf("Expected a BulkError matching ErrNotFound, got %v", err)
+	}
+	if len(result.Succeeded) != len(ids)-1 || result.Succeeded[0] != "inv-1" {
+		t.Errorf("Expected %d successes in order, got %v", len(ids)-1, result.Succeeded)
+	}
+	if len(result.Failed) != 1 || result.Failed["missing"] == nil {
+		t.Errorf("Expected missing to fail, got %v", result.Failed)
+	}
+	if len(revoked) != len(ids)-1 {
+		t.Errorf("Expected %d revoked, got %d", len(ids)-1, len(revoked))
+	}
+	if maxInFlight > BulkConcurrency {
+		t.Errorf("Expected at most %d requests at once, got %d", BulkConcurrency, maxInFlight)
+	}
+}
+
+func TestReinviteMany(t *testing.T) {
+	var mu sync.Mutex
+	keys := make(map[string]string)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id := strings.TrimSuffix(strings.TrimPrefix(r.URL.Path, "/api/v1/invitations/"), "/reinvite")
+		mu.Lock()
+		keys[id] = r.Header.Get("Idempotency-Key")
+		mu.Unlock()
+		w.Write([]byte(`{"id": "` + id + `"}`))
+	}))
+	defer server.Close()
+
+	client := NewClientWithOptions("test-api-key", server.URL, nil)
+
+	result, err := client.ReinviteMany(context.Background(), []string{"inv-1", "inv-2"}, IdempotencyKey("job-7"))
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if len(result.Succeeded) != 2 {
+		t.Errorf("Expected 2 successes, got %v", result.Succeeded)
+	}
+	if keys["inv-1"] != "job-7/inv-1" || keys["inv-2"] != "job-7/inv-2" {
+		t.Errorf("Expected per-invitation idempotency keys, got %v", keys)
+	}
+}
+
+func TestRevokeInvitations_Cancelled(t *testing.T) {
+	client := NewClientWithOptions("test-api-key", "http://127.0.0.1:0", nil)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	result, err := client.RevokeInvitations(ctx, []string{"inv-1", "inv-2"})
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("Expected context.Canceled, got %v", err)
+	}
+	if len(result.Failed) != 2 {
+		t.Errorf("Expected both invitations to fail, got %v", result.Failed)
+	}
+}
+
+func TestAcceptInvitationsBatch(t *testing.T) {
+	var mu sync.Mutex
+	keys := make(map[string]string)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body AcceptInvitationRequest
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil || len(body.InvitationIDs) != 1 {
+			t.Errorf("Expected one invitation per request, got %+v (%v)", body, err)
+			return
+		}
+		id := body.InvitationIDs[0]
+		mu.Lock()
+		keys[id] = r.Header.Get("Idempotency-Key")
+		mu.Unlock()
+		if id == "expired" {
+			w.WriteHeader(http.StatusConflict)
+			return
+		}
+		w.Write([]byte(`{"id": "` + id + `", "status": "accepted"}`))
+	}))
+	defer server.Close()
+
+	client := NewClientWithOptions("test-api-key", server.URL, nil)
+	target := InvitationTarget{Type: "email", Value: "ada@example.com"}
+
+	results, err := client.AcceptInvitationsBatch(context.Background(), []string{"inv-1", "expired", "inv-2"}, target, IdempotencyKey("accept-3"))
+
+	var bulkErr *BulkError
+	if !errors.As(err, &bulkErr) || len(bulkErr.Result.Failed) != 1 {
+		t.Fatalf("Expected a BulkError with one failure, got %v", err)
+	}
+	if len(results) != 3 {
+		t.Fatalf("Expected 3 results, got %d", len(results))
+	}
+	for i, id := range []string{"inv-1", "expired", "inv-2"} {
+		if results[i].InvitationID != id {
+			t.Errorf("Expected result %d for %s, got %s", i, id, results[i].InvitationID)
+		}
+	}
+	if results[0].Err != nil || results[0].Invitation == nil || results[0].Invitation.ID != "inv-1" {
+		t.Errorf("Expected inv-1 to be accepted, got %+v", results[0])
+	}
+	if results[1].Err == nil || results[1].Invitation != nil {
+		t.Errorf("Expected expired to fail, got %+v", results[1])
+	}
+	if keys["inv-1"] != "accept-3/inv-1" || keys["expired"] != "accept-3/expired" {
+		t.Errorf("Expected per-invitation idempotency keys, got %v", keys)
+	}
+}
@@ -0,0 +1,352 @@
+package vortex
+
+import (
+	"container/list"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// Cache stores raw API responses for GetInvitation and GetInvitationsByTarget
+//
+// Implementations must be safe for concurrent use and handle expiry themselves.
+type Cache interface {
+	Get(key string) ([]byte, bool)
+	Set(key string, value []byte)
+	Delete(key string)
+	Clear()
+}
+
+// WithCache caches GetInvitation and GetInvitationsByTarget results
+//
+// Entries are invalidated when the same invitations or targets are changed
+// through the client (revoke, accept, reinvite, delete by group).
+//
+// Example:
+//
+//	client := vortex.NewClient(apiKey, vortex.WithCache(vortex.NewLRUCache(1000, time.Minute)))
+func WithCache(cache Cache) ClientOption {
+	return func(c *Client) {
+		c.cache = cache
+	}
+}
+
+// StaleCache is a Cache that can return entries past their expiry, for
+// WithStaleReads
+type StaleCache interface {
+	Cache
+	// GetStale returns an entry whether or not it has expired, along with the
+	// time it was stored
+	GetStale(key string) (value []byte, storedAt time.Time, ok bool)
+}
+
+// StaleError is returned together with a result served from the cache
+// because the API could not be reached
+type StaleError struct {
+	// Age is how long ago the result was cached
+	Age time.Duration
+	// Err is the error that prevented a fresh read
+	Err error
+}
+
+func (e *StaleError) Error() string {
+	return fmt.Sprintf("serving result cached %s ago: %v", e.Age.Round(time.Second), e.Err)
+}
+
+func (e *StaleError) Unwrap() error {
+	return e.Err
+}
+
+// WithStaleReads serves expired cache entries up to maxAge old when the API is
+// unreachable or failing with a 5xx, so CLIs and batch jobs can keep reading
+// through a brief outage
+//
+// The cache must implement StaleCache, as FileCache does. Stale results are
+// returned together with a *StaleError, so callers must opt in to using them:
+//
+//	invitation, err := client.GetInvitation(id)
+//	var stale *vortex.StaleError
+//	if errors.As(err, &stale) {
+//	    log.Printf("showing data from %s ago", stale.Age)
+//	} else if err != nil {
+//	    return err
+//	}
+func WithStaleReads(maxAge time.Duration) ClientOption {
+	return func(c *Client) {
+		c.staleMaxAge = maxAge
+	}
+}
+
+// staleFallback returns the stale entry for key, if stale reads allow serving
+// it in place of err
+func (c *Client) staleFallback(key string, err error) ([]byte, error) {
+	staleCache, ok := c.cache.(StaleCache)
+	if !ok || c.staleMaxAge <= 0 || !unreachable(err) {
+		return nil, err
+	}
+
+	value, storedAt, ok := staleCache.GetStale(key)
+	if !ok || time.Since(storedAt) > c.staleMaxAge {
+		return nil, err
+	}
+	return value, &StaleError{Age: time.Since(storedAt), Err: err}
+}
+
+// unreachable reports whether err means the API could not serve the request,
+// as opposed to rejecting it or the caller giving up
+func unreachable(err error) bool {
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		return apiErr.StatusCode >= 500
+	}
+	return true
+}
+
+// isStale reports whether err only marks a result as stale
+func isStale(err error) bool {
+	var stale *StaleError
+	return errors.As(err, &stale)
+}
+
+// cachedRequest makes a GET request, serving it from the cache when possible
+func (c *Client) cachedRequest(ctx context.Context, key, path string, queryParams map[string]string, opts []RequestOption) ([]byte, error) {
+	// Request options may change the response, e.g. Locale
+	if hasRequestOptions(ctx, opts) || !c.readsCache() {
+		return c.apiRequestContext(ctx, "GET", path, nil, queryParams, opts...)
+	}
+
+	if value, ok := c.cache.Get(key); ok {
+		return value, nil
+	}
+
+	responseBody, err := c.apiRequestContext(ctx, "GET", path, nil, queryParams)
+	if err != nil {
+		return c.staleFallback(key, err)
+	}
+
+	c.cache.Set(key, responseBody)
+	return responseBody, nil
+}
+
+// cachedList makes a GET request for a list of invitations, serving it from
+// the cache when possible
+//
+// Each invitation in a cached list gets an index entry naming the lists that
+// contain it, so invalidating the invitation drops those lists too. A cached
+// list is only served while all of its index entries are intact, since the
+// cache may evict them independently.
+func (c *Client) cachedList(ctx context.Context, key, path string, queryParams map[string]string) ([]InvitationResult, error) {
+	cached := c.readsCache()
+	if cached {
+		if value, ok := c.cache.Get(key); ok {
+			var response InvitationsResponse
+			if json.Unmarshal(value, &response) == nil && c.indexed(key, response.Invitations) {
+				return response.Invitations, nil
+			}
+		}
+	}
+
+	responseBody, err := c.apiRequestContext(ctx, "GET", path, nil, queryParams)
+	if err != nil && !cached {
+		return nil, err
+	}
+	if err != nil {
+		staleBody, staleErr := c.staleFallback(key, err)
+		if staleBody == nil {
+			return nil, staleErr
+		}
+		var response InvitationsResponse
+		if err := c.decode(staleBody, &response); err != nil {
+			return nil, staleErr
+		}
+		return response.Invitations, staleErr
+	}
+
+	var response InvitationsResponse
+	if err := c.decode(responseBody, &response); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	if cached {
+		for _, invitation := range response.Invitations {
+			keys := c.indexEntry(invitation.ID)
+			if !containsKey(keys, key) {
+				value, _ := json.Marshal(append(keys, key))
+				c.cache.Set(indexCacheKey(invitation.ID), value)
+			}
+		}
+		c.cache.Set(key, responseBody)
+	}
+	return response.Invitations, nil
+}
+
+// indexed reports whether every invitation in a cached list still points back to it
+func (c *Client) indexed(key string, invitations []InvitationResult) bool {
+	for _, invitation := range invitations {
+		if !containsKey(c.indexEntry(invitation.ID), key) {
+			return false
+		}
+	}
+	return true
+}
+
+// indexEntry returns the cached list keys that contain an invitation
+func (c *Client) indexEntry(invitationID string) []string {
+	var keys []string
+	if value, ok := c.cache.Get(indexCacheKey(invitationID)); ok {
+		json.Unmarshal(value, &keys)
+	}
+	return keys
+}
+
+func containsKey(keys []string, key string) bool {
+	for _, k := range keys {
+		if k == key {
+			return true
+		}
+	}
+	return false
+}
+
+// readsCache reports whether responses are served from and stored in the
+// cache; AsUser clients only invalidate it, since their responses may differ
+// per user
+func (c *Client) readsCache() bool {
+	return c.cache != nil && c.asUser == nil
+}
+
+func (c *Client) invalidate(key string) {
+	if c.cache != nil {
+		c.cache.Delete(key)
+	}
+}
+
+// invalidateInvitation drops a cached invitation and the target lookups it
+// appears in
+func (c *Client) invalidateInvitation(invitationID string) {
+	if c.cache == nil {
+		return
+	}
+
+	for _, listKey := range c.indexEntry(invitationID) {
+		c.cache.Delete(listKey)
+	}
+	c.cache.Delete(indexCacheKey(invitationID))
+
+	key := invitationCacheKey(invitationID)
+	if value, ok := c.cache.Get(key); ok {
+		var invitation InvitationResult
+		if json.Unmarshal(value, &invitation) == nil {
+			for _, target := range invitation.Target {
+				c.cache.Delete(targetCacheKey(target.Type, target.Value))
+			}
+		}
+	}
+	c.cache.Delete(key)
+}
+
+func invitationCacheKey(invitationID string) string {
+	return "invitation:" + invitationID
+}
+
+func indexCacheKey(invitationID string) string {
+	return "index:" + invitationID
+}
+
+func targetCacheKey(targetType TargetType, targetValue string) string {
+	return "target:" + string(targetType) + ":" + targetValue
+}
+
+// LRUCache is an in-process Cache holding up to size entries for ttl each
+type LRUCache struct {
+	mu      sync.Mutex
+	size    int
+	ttl     time.Duration
+	order   *list.List
+	entries map[string]*list.Element
+}
+
+type lruEntry struct {
+	key     string
+	value   []byte
+	expires time.Time
+}
+
+// NewLRUCache creates a cache evicting the least recently used entry beyond size
+func NewLRUCache(size int, ttl time.Duration) *LRUCache {
+	return &LRUCache{
+		size:    size,
+		ttl:     ttl,
+		order:   list.New(),
+		entries: make(map[string]*list.Element),
+	}
+}
+
+// Get implements Cache
+func (c *LRUCache) Get(key string) ([]byte, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	element, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+
+	entry := element.Value.(*lruEntry)
+	if time.Now().After(entry.expires) {
+		c.order.Remove(element)
+		delete(c.entries, key)
+		return nil, false
+	}
+
+	c.order.MoveToFront(element)
+	return entry.value, true
+}
+
+// Set implements Cache
+func (c *LRUCache) Set(key string, value []byte) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	expires := time.Now().Add(c.ttl)
+	if element, ok := c.entries[key]; ok {
+		entry := element.Value.(*lruEntry)
+		entry.value = value
+		entry.expires = expires
+		c.order.MoveToFront(element)
+		return
+	}
+
+	c.entries[key] = c.order.PushFront(&lruEntry{key: key, value: value, expires: expires})
+	for c.order.Len() > c.size {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.entries, oldest.Value.(*lruEntry).key)
+	}
+}
+
+// Delete implements Cache
+func (c *LRUCache) Delete(key string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if element, ok := c.entries[key]; ok {
+		c.order.Remove(element)
+		delete(c.entries, key)
+	}
+}
+
+// Clear implements Cache
+func (c *LRUCache) Clear() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.order.Init()
+	c.entries = make(map[string]*list.Element)
+}
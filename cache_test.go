@@ -0,0 +1,120 @@
+package vortex
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestLRUCache(t *testing.T) {
+	cache := NewLRUCache(2, time.Minute)
+
+	cache.Set("a", []byte("1"))
+	cache.Set("b", []byte("2"))
+	cache.Get("a")
+	cache.Set("c", []byte("3"))
+
+	if _, ok := cache.Get("b"); ok {
+		t.Error("Expected least recently used entry to be evicted")
+	}
+	if value, ok := cache.Get("a"); !ok || string(value) != "1" {
+		t.Errorf("Expected entry a to be cached, got %q (ok=%v)", value, ok)
+	}
+
+	cache.Delete("a")
+	if _, ok := cache.Get("a"); ok {
+		t.Error("Expected entry a to be deleted")
+	}
+
+	expiring := NewLRUCache(2, -time.Second)
+	expiring.Set("a", []byte("1"))
+	if _, ok := expiring.Get("a"); ok {
+		t.Error("Expected expired entry to be missing")
+	}
+}
+
+func TestWithCache(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		switch {
+		case r.Method == "GET" && r.URL.Path == "/api/v1/invitations":
+			json.NewEncoder(w).Encode(InvitationsResponse{Invitations: []InvitationResult{{ID: "inv-1"}}})
+		case r.Method == "GET":
+			json.NewEncoder(w).Encode(InvitationResult{
+				ID:     "inv-1",
+				Target: []InvitationTarget{{Type: "email", Value: "test@example.com"}},
+			})
+		default:
+			w.WriteHeader(http.StatusNoContent)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClientWithOptions("test-api-key", server.URL, nil, WithCache(NewLRUCache(10, time.Minute)))
+
+	for i := 0; i < 2; i++ {
+		if _, err := client.GetInvitation("inv-1"); err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if _, err := client.GetInvitationsByTarget("email", "test@example.com"); err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+	}
+	if requests != 2 {
+		t.Fatalf("Expected 2 requests with cached results, got %d", requests)
+	}
+
+	// Revoking drops the invitation and the target lookup it appears in
+	if err := client.RevokeInvitation("inv-1"); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	client.GetInvitation("inv-1")
+	client.GetInvitationsByTarget("email", "test@example.com")
+	if requests != 5 {
+		t.Errorf("Expected cache to be invalidated after revoke, got %d requests", requests)
+	}
+}
+
+func TestWithCache_InvalidatesTargetListsOnly(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "GET" {
+			requests++
+			json.NewEncoder(w).Encode(InvitationsResponse{Invitations: []InvitationResult{{ID: "inv-1"}}})
+			return
+		}
+		w.Write([]byte(`{"id":"inv-1"}`))
+	}))
+	defer server.Close()
+
+	cache := NewLRUCache(10, time.Minute)
+	client := NewClientWithOptions("test-api-key", server.URL, nil, WithCache(cache))
+
+	// The invitation itself is never fetched, only the lists it appears in
+	mutations := []func() error{
+		func() error { return client.RevokeInvitation("inv-1") },
+		func() error { _, err := client.Reinvite("inv-1"); return err },
+	}
+	for i, mutate := range mutations {
+		client.GetInvitationsByTarget("email", "a@b.c")
+		if err := mutate(); err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		client.GetInvitationsByTarget("email", "a@b.c")
+
+		if expected := i + 2; requests != expected {
+			t.Errorf("Expected %d requests, got %d", expected, requests)
+		}
+	}
+
+	// A list whose index entry was evicted is fetched again
+	client.GetInvitationsByTarget("email", "a@b.c")
+	cache.Delete(indexCacheKey("inv-1"))
+	client.GetInvitationsByTarget("email", "a@b.c")
+	if requests != 4 {
+		t.Errorf("Expected list to be refetched after its index was evicted, got %d requests", requests)
+	}
+}
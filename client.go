@@ -2,49 +2,132 @@ package vortex
 
 import (
 	"bytes"
-	"crypto/hmac"
-	"crypto/sha256"
+	"context"
 	"encoding/base64"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
 	"net/url"
 	"os"
 	"strings"
+	"sync"
 	"time"
 
-	"github.com/google/uuid"
+	"github.com/TeamVortexSoftware/vortex-go-sdk/vortexjwt"
 )
 
 const (
 	defaultBaseURL = "https://api.vortexsoftware.com"
 	userAgent      = "vortex-go-sdk/1.0.0"
+
+	// jwtTTL is how long generated JWTs remain valid
+	jwtTTL = time.Hour
+
+	// defaultMaxResponseSize bounds how much of a response body is read
+	defaultMaxResponseSize = 32 << 20
 )
 
+// ErrJWTExpired is returned by VerifyJWT for tokens whose expires claim has
+// passed
+var ErrJWTExpired = errors.New("JWT has expired")
+
+// ErrJWTNotYetValid is returned by VerifyJWT for tokens whose notBefore claim
+// is in the future
+var ErrJWTNotYetValid = errors.New("JWT is not valid yet")
+
+// ErrResponseTooLarge is returned when a response body exceeds the maximum
+// response size
+var ErrResponseTooLarge = errors.New("vortex: response body too large")
+
+// WithMaxResponseSize limits how many bytes of a response body are read, so a
+// misbehaving endpoint or proxy cannot exhaust memory; the default is 32 MiB
+//
+// Larger responses fail with an error wrapping ErrResponseTooLarge. A limit of
+// zero or less removes the limit.
+func WithMaxResponseSize(bytes int64) ClientOption {
+	return func(c *Client) {
+		if bytes <= 0 {
+			bytes = -1
+		}
+		c.maxResponseSize = bytes
+	}
+}
+
 // Client represents a Vortex API client
 type Client struct {
 	apiKey     string
 	baseURL    string
 	httpClient *http.Client
+	cache      Cache
+	limiters   map[EndpointClass]RateLimiter
+	inflight   chan struct{}
+	onError    func(ctx context.Context, err error)
+	logger     Logger
+	shortener  Shortener
+
+	staleMaxAge time.Duration
+
+	// maxResponseSize is zero for the default and negative for no limit
+	maxResponseSize int64
+
+	strictDecoding bool
+	onSchemaDrift  func(err *SchemaDriftError)
+
+	onDeprecation func(ctx context.Context, notice DeprecationNotice)
+	deprecations  *deprecationWarnings
+
+	locale    string
+	userAgent string
+
+	retry    *RetryPolicy
+	failover *failoverState
+
+	rateLimits *rateLimitState
+	responses  *responseState
+
+	signingKeys *signingKeyCache
+
+	middleware []Middleware
+	debug      *debugWriter
+	metrics    MetricsCollector
+
+	// asUser is set on clients returned by AsUser
+	asUser *User
+	tokens *TokenTransport
 }
 
+// ClientOption configures optional Client behavior
+type ClientOption func(*Client)
+
 // NewClient creates a new Vortex client
-func NewClient(apiKey string) *Client {
+func NewClient(apiKey string, opts ...ClientOption) *Client {
 	baseURL := os.Getenv("VORTEX_API_BASE_URL")
 	if baseURL == "" {
 		baseURL = defaultBaseURL
 	}
 
-	return &Client{
+	c := &Client{
 		apiKey:     apiKey,
 		baseURL:    baseURL,
 		httpClient: &http.Client{Timeout: 30 * time.Second},
 	}
+	c.tokens = NewTokenTransport(c, nil)
+	c.deprecations = &deprecationWarnings{}
+	c.rateLimits = &rateLimitState{}
+	c.responses = &responseState{}
+	c.signingKeys = &signingKeyCache{}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
 }
 
 // NewClientWithOptions creates a new Vortex client with custom options
-func NewClientWithOptions(apiKey, baseURL string, httpClient *http.Client) *Client {
+//
+// It is equivalent to NewClient with WithBaseURL and WithHTTPClient.
+func NewClientWithOptions(apiKey, baseURL string, httpClient *http.Client, opts ...ClientOption) *Client {
 	if baseURL == "" {
 		baseURL = defaultBaseURL
 	}
@@ -52,11 +135,73 @@ func NewClientWithOptions(apiKey, baseURL string, httpClient *http.Client) *Clie
 		httpClient = &http.Client{Timeout: 30 * time.Second}
 	}
 
-	return &Client{
+	c := &Client{
 		apiKey:     apiKey,
 		baseURL:    baseURL,
 		httpClient: httpClient,
 	}
+	c.tokens = NewTokenTransport(c, nil)
+	c.deprecations = &deprecationWarnings{}
+	c.rateLimits = &rateLimitState{}
+	c.responses = &responseState{}
+	c.signingKeys = &signingKeyCache{}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+// WithBaseURL sends requests to baseURL instead of the Vortex API, overriding
+// VORTEX_API_BASE_URL
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) {
+		if baseURL != "" {
+			c.baseURL = baseURL
+		}
+	}
+}
+
+// WithHTTPClient sends requests with httpClient, e.g. one configured with a
+// proxy or custom transport
+func WithHTTPClient(httpClient *http.Client) ClientOption {
+	return func(c *Client) {
+		if httpClient != nil {
+			c.httpClient = httpClient
+		}
+	}
+}
+
+// WithTimeout limits how long each request may take; the default is 30
+// seconds
+//
+// Options apply in order, so pass WithTimeout after WithHTTPClient. The HTTP
+// client passed to WithHTTPClient is copied rather than changed.
+func WithTimeout(timeout time.Duration) ClientOption {
+	return func(c *Client) {
+		httpClient := *c.httpClient
+		httpClient.Timeout = timeout
+		c.httpClient = &httpClient
+	}
+}
+
+// WithUserAgent identifies the application in the User-Agent header, ahead of
+// the SDK's own product token
+//
+// Example:
+//
+//	client := vortex.NewClient(apiKey, vortex.WithUserAgent("billing-service/2.3"))
+//	// User-Agent: billing-service/2.3 vortex-go-sdk/1.0.0
+func WithUserAgent(product string) ClientOption {
+	return func(c *Client) {
+		c.userAgent = product
+	}
+}
+
+func (c *Client) userAgentHeader() string {
+	if c.userAgent == "" {
+		return userAgent
+	}
+	return c.userAgent + " " + userAgent
 }
 
 // GenerateJWT creates a JWT token with the given user data and optional extra properties
@@ -64,6 +209,8 @@ func NewClientWithOptions(apiKey, baseURL string, httpClient *http.Client) *Clie
 // The user parameter should contain the user's ID, email, and optional admin scopes.
 // If adminScopes is provided, the full array will be included in the JWT payload.
 // The extra parameter can contain additional properties to include in the JWT payload.
+// Properties that would overwrite the user's claims or a registered JWT claim
+// such as sub or exp fail with ErrReservedClaim.
 //
 // Example:
 //
@@ -82,47 +229,52 @@ func NewClientWithOptions(apiKey, baseURL string, httpClient *http.Client) *Clie
 //	}
 //	jwt, err := client.GenerateJWT(user, extra)
 func (c *Client) GenerateJWT(user *User, extra map[string]interface{}) (string, error) {
-	// Parse API key: format is VRTX.base64encodedId.key
-	parts := strings.Split(c.apiKey, ".")
-	if len(parts) != 3 {
-		return "", fmt.Errorf("invalid API key format")
-	}
+	return c.GenerateJWTWithOptions(user, extra, JWTOptions{})
+}
 
-	prefix := parts[0]
-	encodedID := parts[1]
-	key := parts[2]
+// JWTOptions controls the lifetime of a generated JWT
+type JWTOptions struct {
+	// TTL is how long the token is valid for; the default is one hour
+	TTL time.Duration
+	// NotBefore, if set, is when the token becomes valid
+	NotBefore time.Time
+	// IssuedAt is the token's iat; the default is now
+	IssuedAt time.Time
+	// Leeway backdates iat and NotBefore, so servers whose clock runs behind
+	// still accept a freshly issued token
+	Leeway time.Duration
+}
 
-	if prefix != "VRTX" {
-		return "", fmt.Errorf("invalid API key prefix")
+// GenerateJWTWithOptions is like GenerateJWT with a custom lifetime, e.g. a
+// short-lived token for the widget or a long-lived one for a service
+//
+// Example:
+//
+//	jwt, err := client.GenerateJWTWithOptions(user, nil, vortex.JWTOptions{
+//	    TTL:    5 * time.Minute,
+//	    Leeway: 30 * time.Second,
+//	})
+func (c *Client) GenerateJWTWithOptions(user *User, extra map[string]interface{}, opts JWTOptions) (string, error) {
+	if err := checkClaims(extra); err != nil {
+		return "", err
 	}
 
-	// Decode the UUID from base64url
-	uuidBytes, err := base64.RawURLEncoding.DecodeString(encodedID)
+	// Step 1: Derive signing key from API key + ID
+	key, err := c.signingKey()
 	if err != nil {
-		return "", fmt.Errorf("failed to decode API key ID: %w", err)
+		return "", err
 	}
 
-	// Convert bytes to UUID string
-	id, err := uuid.FromBytes(uuidBytes)
-	if err != nil {
-		return "", fmt.Errorf("failed to parse UUID from API key: %w", err)
+	// Step 2: Build payload
+	now := opts.IssuedAt
+	if now.IsZero() {
+		now = time.Now()
 	}
-
-	// Step 1: Derive signing key from API key + ID
-	signingKeyHmac := hmac.New(sha256.New, []byte(key))
-	signingKeyHmac.Write([]byte(id.String()))
-	signingKey := signingKeyHmac.Sum(nil)
-
-	// Step 2: Build header + payload
-	now := time.Now().Unix()
-	expires := now + 3600 // 1 hour
-
-	header := JWTHeader{
-		IAT: now,
-		Alg: "HS256",
-		Typ: "JWT",
-		Kid: id.String(),
+	ttl := opts.TTL
+	if ttl <= 0 {
+		ttl = jwtTTL
 	}
+	expires := now.Add(ttl).Unix()
 
 	// Build payload with required fields
 	payload := map[string]interface{}{
@@ -131,6 +283,10 @@ func (c *Client) GenerateJWT(user *User, extra map[string]interface{}) (string,
 		"expires":   expires,
 	}
 
+	if !opts.NotBefore.IsZero() {
+		payload["notBefore"] = opts.NotBefore.Add(-opts.Leeway).Unix()
+	}
+
 	// Add adminScopes if present
 	if user.AdminScopes != nil {
 		payload["adminScopes"] = user.AdminScopes
@@ -143,34 +299,163 @@ func (c *Client) GenerateJWT(user *User, extra map[string]interface{}) (string,
 		}
 	}
 
-	// Step 3: Base64URL encode header and payload
-	headerJSON, err := json.Marshal(header)
+	// Step 3: Encode and sign
+	return key.Sign(payload, now.Add(-opts.Leeway))
+}
+
+// VerifyJWT validates a JWT issued with this client's API key and returns its claims
+//
+// The signature is checked against the signing key derived from the API key,
+// and tokens whose expires claim is in the past, or whose notBefore claim is
+// in the future, are rejected.
+func (c *Client) VerifyJWT(token string) (*JWTClaims, error) {
+	key, err := c.signingKey()
+	if err != nil {
+		return nil, err
+	}
+
+	payloadJSON, err := key.Verify(token)
+	if err != nil {
+		return nil, err
+	}
+
+	var claims JWTClaims
+	if err := json.Unmarshal(payloadJSON, &claims); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal JWT payload: %w", err)
+	}
+
+	now := time.Now().Unix()
+	if claims.Expires <= now {
+		return nil, ErrJWTExpired
+	}
+	if claims.NotBefore > now {
+		return nil, ErrJWTNotYetValid
+	}
+
+	return &claims, nil
+}
+
+// ParseJWT decodes a JWT's claims without verifying its signature or expiry
+//
+// Use it only to inspect tokens, e.g. when logging why one was rejected; use
+// VerifyJWT to decide whether to trust one.
+func ParseJWT(token string) (*JWTClaims, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return nil, fmt.Errorf("invalid JWT format")
+	}
+
+	payloadJSON, err := base64.RawURLEncoding.DecodeString(parts[1])
 	if err != nil {
-		return "", fmt.Errorf("failed to marshal JWT header: %w", err)
+		return nil, fmt.Errorf("failed to decode JWT payload: %w", err)
+	}
+
+	var claims JWTClaims
+	if err := json.Unmarshal(payloadJSON, &claims); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal JWT payload: %w", err)
 	}
 
-	payloadJSON, err := json.Marshal(payload)
+	return &claims, nil
+}
+
+// signingKeyCache holds the signing key derived from a client's API key,
+// which never changes, so it is derived once and shared with AsUser copies
+type signingKeyCache struct {
+	once sync.Once
+	key  *vortexjwt.Key
+	err  error
+}
+
+// signingKey derives the JWT signing key from the API key
+func (c *Client) signingKey() (*vortexjwt.Key, error) {
+	cache := c.signingKeys
+	if cache == nil {
+		return vortexjwt.ParseAPIKey(c.apiKey)
+	}
+	cache.once.Do(func() {
+		cache.key, cache.err = vortexjwt.ParseAPIKey(c.apiKey)
+	})
+	return cache.key, cache.err
+}
+
+// apiRequestContext makes an HTTP request to the Vortex API bound to ctx
+func (c *Client) apiRequestContext(ctx context.Context, method, path string, body interface{}, queryParams map[string]string, opts ...RequestOption) ([]byte, error) {
+	resp, err := c.send(ctx, method, path, body, queryParams, opts)
 	if err != nil {
-		return "", fmt.Errorf("failed to marshal JWT payload: %w", err)
+		return nil, err
 	}
 
-	headerB64 := base64.RawURLEncoding.EncodeToString(headerJSON)
-	payloadB64 := base64.RawURLEncoding.EncodeToString(payloadJSON)
+	// Handle empty responses
+	if len(resp.Body) == 0 {
+		return []byte("{}"), nil
+	}
 
-	// Step 4: Sign
-	toSign := headerB64 + "." + payloadB64
-	signatureHmac := hmac.New(sha256.New, signingKey)
-	signatureHmac.Write([]byte(toSign))
-	signature := base64.RawURLEncoding.EncodeToString(signatureHmac.Sum(nil))
+	return resp.Body, nil
+}
+
+// send makes an HTTP request to the Vortex API, logging it and reporting
+// errors to the OnError hook
+func (c *Client) send(ctx context.Context, method, path string, body interface{}, queryParams map[string]string, opts []RequestOption) (*Response, error) {
+	opts = withContextOptions(ctx, opts)
+	start := time.Now()
+	resp, err := c.doRequest(ctx, method, path, body, queryParams, opts)
 
-	jwt := toSign + "." + signature
-	return jwt, nil
+	if c.logger != nil {
+		if err != nil {
+			c.logger.Error("vortex request failed", "method", method, "path", path, "duration", time.Since(start), "error", err)
+		} else {
+			c.logger.Debug("vortex request", "method", method, "path", path, "duration", time.Since(start))
+		}
+	}
+	if err != nil && c.onError != nil {
+		c.onError(ctx, err)
+	}
+	return resp, err
 }
 
-// apiRequest makes an HTTP request to the Vortex API
-func (c *Client) apiRequest(method, path string, body interface{}, queryParams map[string]string) ([]byte, error) {
+// doRequest sends a request and reads its response, retrying transient
+// failures if the client has a retry policy
+func (c *Client) doRequest(ctx context.Context, method, path string, body interface{}, queryParams map[string]string, opts []RequestOption) (*Response, error) {
+	if c.retry == nil || !c.retry.allows(method, opts) {
+		return c.doAttempt(ctx, method, path, body, queryParams, opts)
+	}
+
+	for attempt := 1; ; attempt++ {
+		resp, err := c.doAttempt(ctx, method, path, body, queryParams, opts)
+		if err == nil || attempt >= c.retry.MaxAttempts || !c.retryable(ctx, err) {
+			return resp, err
+		}
+
+		delay, ok := c.retry.delay(attempt, err)
+		if !ok {
+			return nil, err
+		}
+		if c.logger != nil {
+			c.logger.Warn("vortex request failed, retrying", "method", method, "path", path, "attempt", attempt, "delay", delay, "error", err)
+		}
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, err
+		case <-timer.C:
+		}
+	}
+}
+
+// doAttempt makes a single attempt at a request, failing over to another
+// environment if the client has fallbacks
+func (c *Client) doAttempt(ctx context.Context, method, path string, body interface{}, queryParams map[string]string, opts []RequestOption) (*Response, error) {
+	return c.withFailover(ctx, func(baseURL string) (*Response, error) {
+		return c.doAttemptAt(ctx, baseURL, method, path, body, queryParams, opts)
+	})
+}
+
+// doAttemptAt makes a single attempt at a request against baseURL
+func (c *Client) doAttemptAt(ctx context.Context, baseURL, method, path string, body interface{}, queryParams map[string]string, opts []RequestOption) (*Response, error) {
 	// Build URL
-	u, err := url.Parse(c.baseURL + path)
+	u, err := url.Parse(baseURL + path)
 	if err != nil {
 		return nil, fmt.Errorf("failed to parse URL: %w", err)
 	}
@@ -186,7 +471,9 @@ func (c *Client) apiRequest(method, path string, body interface{}, queryParams m
 
 	// Prepare request body
 	var bodyReader io.Reader
-	if body != nil {
+	if raw, ok := body.(*rawBody); ok {
+		bodyReader = raw.reader()
+	} else if body != nil {
 		bodyBytes, err := json.Marshal(body)
 		if err != nil {
 			return nil, fmt.Errorf("failed to marshal request body: %w", err)
@@ -195,28 +482,74 @@ func (c *Client) apiRequest(method, path string, body interface{}, queryParams m
 	}
 
 	// Create request
-	req, err := http.NewRequest(method, u.String(), bodyReader)
+	req, err := http.NewRequestWithContext(ctx, method, u.String(), bodyReader)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create request: %w", err)
 	}
+	if raw, ok := body.(*rawBody); ok {
+		req.ContentLength = int64(len(raw.data))
+	}
 
 	// Set headers
-	req.Header.Set("Content-Type", "application/json")
-	req.Header.Set("x-api-key", c.apiKey)
-	req.Header.Set("User-Agent", userAgent)
+	contentType := "application/json"
+	if typed, ok := body.(interface{ contentType() string }); ok {
+		contentType = typed.contentType()
+	}
+	req.Header.Set("Content-Type", contentType)
+	if err := c.setHeaders(req); err != nil {
+		return nil, err
+	}
+	options := applyRequestOptions(req, opts)
 
 	// Make request
-	resp, err := c.httpClient.Do(req)
+	if err := c.waitForLimiter(ctx, method); err != nil {
+		return nil, err
+	}
+	release, err := c.acquireSlot(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+	start := time.Now()
+	resp, err := c.roundTrip(req)
 	if err != nil {
 		return nil, fmt.Errorf("request failed: %w", err)
 	}
 	defer resp.Body.Close()
+	c.checkDeprecation(ctx, method, path, resp.Header)
+	rateLimit := parseRateLimit(resp.Header, time.Now())
+	c.recordRateLimit(rateLimit)
 
 	// Read response
-	responseBody, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return nil, fmt.Errorf("failed to read response body: %w", err)
+	var responseBody []byte
+	if options.sink != nil && resp.StatusCode < 400 {
+		if _, err := io.Copy(options.sink, resp.Body); err != nil {
+			return nil, fmt.Errorf("failed to read response body: %w", err)
+		}
+	} else {
+		maxResponseSize := c.maxResponseSize
+		if maxResponseSize == 0 {
+			maxResponseSize = defaultMaxResponseSize
+		}
+		var responseReader io.Reader = resp.Body
+		if maxResponseSize > 0 {
+			responseReader = io.LimitReader(resp.Body, maxResponseSize+1)
+		}
+		responseBody, err = io.ReadAll(responseReader)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read response body: %w", err)
+		}
+		if maxResponseSize > 0 && int64(len(responseBody)) > maxResponseSize {
+			return nil, fmt.Errorf("%w: %s %s exceeded %d bytes", ErrResponseTooLarge, method, path, maxResponseSize)
+		}
 	}
+	c.recordResponse(&ResponseMetadata{
+		StatusCode: resp.StatusCode,
+		RequestID:  resp.Header.Get("X-Request-Id"),
+		RateLimit:  rateLimit,
+		Duration:   time.Since(start),
+		Header:     resp.Header,
+	}, options)
 
 	// Check for errors
 	if resp.StatusCode >= 400 {
@@ -224,49 +557,174 @@ func (c *Client) apiRequest(method, path string, body interface{}, queryParams m
 			StatusCode: resp.StatusCode,
 			Message:    fmt.Sprintf("Vortex API request failed: %d %s", resp.StatusCode, resp.Status),
 			Details:    string(responseBody),
+			Method:     method,
+			Path:       path,
+			RequestID:  resp.Header.Get("X-Request-Id"),
+			RetryAfter: parseRetryAfter(resp.Header.Get("Retry-After"), time.Now()),
+			RateLimit:  rateLimit,
+		}
+		// Without a Retry-After, an exhausted window says how long to wait
+		if resp.StatusCode == http.StatusTooManyRequests && apiErr.RetryAfter == 0 &&
+			rateLimit != nil && rateLimit.Remaining == 0 {
+			apiErr.RetryAfter = time.Until(rateLimit.Reset)
+			if apiErr.RetryAfter < 0 {
+				apiErr.RetryAfter = 0
+			}
+		}
+		switch resp.StatusCode {
+		case http.StatusUnprocessableEntity:
+			if validationErr := parseValidationError(responseBody); validationErr != nil {
+				apiErr.cause = validationErr
+			}
+		case http.StatusPreconditionFailed:
+			apiErr.cause = &ConflictError{Current: responseBody}
 		}
 		return nil, apiErr
 	}
 
-	// Handle empty responses
-	if len(responseBody) == 0 || string(responseBody) == "" {
-		return []byte("{}"), nil
-	}
+	return &Response{
+		StatusCode: resp.StatusCode,
+		Header:     resp.Header,
+		Body:       responseBody,
+	}, nil
+}
 
-	return responseBody, nil
+// setHeaders sets the headers every request carries: the API key, user
+// agent, locale and, for AsUser clients, the user's JWT
+func (c *Client) setHeaders(req *http.Request) error {
+	req.Header.Set("x-api-key", c.apiKey)
+	req.Header.Set("User-Agent", c.userAgentHeader())
+	if c.locale != "" {
+		req.Header.Set("Accept-Language", c.locale)
+	}
+	if c.asUser != nil {
+		token, err := c.tokens.Token(c.asUser)
+		if err != nil {
+			return err
+		}
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	return nil
 }
 
-// GetInvitationsByTarget retrieves invitations by target type and value
-func (c *Client) GetInvitationsByTarget(targetType, targetValue string) ([]InvitationResult, error) {
-	queryParams := map[string]string{
-		"targetType":  targetType,
-		"targetValue": targetValue,
+// ListOption filters the invitations returned by list calls
+type ListOption func(queryParams map[string]string)
+
+// listInvitations fetches a list of invitations with opts applied
+func (c *Client) listInvitations(ctx context.Context, path string, queryParams map[string]string, opts []ListOption) ([]InvitationResult, error) {
+	if queryParams == nil {
+		queryParams = make(map[string]string)
+	}
+	for _, opt := range opts {
+		opt(queryParams)
 	}
 
-	responseBody, err := c.apiRequest("GET", "/api/v1/invitations", nil, queryParams)
+	responseBody, err := c.apiRequestContext(ctx, "GET", path, nil, queryParams)
 	if err != nil {
 		return nil, err
 	}
 
 	var response InvitationsResponse
-	if err := json.Unmarshal(responseBody, &response); err != nil {
+	if err := c.decode(responseBody, &response); err != nil {
 		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
 	}
 
 	return response.Invitations, nil
 }
 
+// GetInvitationsByTarget retrieves invitations by target type and value
+//
+// An unknown target type fails with ErrInvalidTargetType rather than
+// silently returning no invitations.
+//
+// Filtered results, and calls with RequestOptions from WithRequestOptions,
+// are never served from the cache.
+func (c *Client) GetInvitationsByTarget(targetType, targetValue string, opts ...ListOption) ([]InvitationResult, error) {
+	return c.GetInvitationsByTargetContext(context.Background(), targetType, targetValue, opts...)
+}
+
+// GetInvitationsByTargetContext is like GetInvitationsByTarget but bound to ctx
+func (c *Client) GetInvitationsByTargetContext(ctx context.Context, targetType, targetValue string, opts ...ListOption) ([]InvitationResult, error) {
+	return c.GetInvitationsForTarget(ctx, InvitationTarget{Type: TargetType(targetType), Value: targetValue}, opts...)
+}
+
+// GetInvitationsForTarget is like GetInvitationsByTargetContext but takes a
+// typed InvitationTarget
+//
+// Example:
+//
+//	invitations, err := client.GetInvitationsForTarget(ctx, vortex.InvitationTarget{
+//	    Type:  vortex.TargetEmail,
+//	    Value: "user@example.com",
+//	})
+func (c *Client) GetInvitationsForTarget(ctx context.Context, target InvitationTarget, opts ...ListOption) ([]InvitationResult, error) {
+	if !target.Type.Valid() {
+		return nil, fmt.Errorf("%w %q", ErrInvalidTargetType, target.Type)
+	}
+
+	queryParams := map[string]string{
+		"targetType":  string(target.Type),
+		"targetValue": target.Value,
+	}
+
+	if len(opts) > 0 || hasRequestOptions(ctx, nil) {
+		return c.listInvitations(ctx, "/api/v1/invitations", queryParams, opts)
+	}
+	return c.cachedList(ctx, targetCacheKey(target.Type, target.Value), "/api/v1/invitations", queryParams)
+}
+
 // GetInvitation retrieves a specific invitation by ID
-func (c *Client) GetInvitation(invitationID string) (*InvitationResult, error) {
+func (c *Client) GetInvitation(invitationID string, opts ...RequestOption) (*InvitationResult, error) {
+	return c.GetInvitationContext(context.Background(), invitationID, opts...)
+}
+
+// GetInvitationContext is like GetInvitation but bound to ctx
+func (c *Client) GetInvitationContext(ctx context.Context, invitationID string, opts ...RequestOption) (*InvitationResult, error) {
 	path := fmt.Sprintf("/api/v1/invitations/%s", invitationID)
 
-	responseBody, err := c.apiRequest("GET", path, nil, nil)
+	responseBody, err := c.cachedRequest(ctx, invitationCacheKey(invitationID), path, nil, opts)
+	if err != nil && !isStale(err) {
+		return nil, err
+	}
+
+	var invitation InvitationResult
+	if err := c.decode(responseBody, &invitation); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	return &invitation, err
+}
+
+// CreateInvitation creates an invitation and sends it to its targets
+//
+// A random Idempotency-Key is sent unless one is given with IdempotencyKey.
+//
+// Example:
+//
+//	invitation, err := client.CreateInvitation(ctx, vortex.CreateInvitationRequest{
+//	    WidgetConfigurationID: "widget-config-id",
+//	    Targets:               []vortex.InvitationTarget{{Type: "email", Value: "user@example.com"}},
+//	    InviterID:             "user-123",
+//	    Groups:                []vortex.GroupRef{{Type: "workspace", GroupID: "ws-123"}},
+//	    Expires:               vortex.ExpiryTime(time.Now().Add(7 * 24 * time.Hour)),
+//	})
+func (c *Client) CreateInvitation(ctx context.Context, req CreateInvitationRequest, opts ...RequestOption) (*InvitationResult, error) {
+	opts, err := withIdempotencyKey(ctx, opts)
 	if err != nil {
 		return nil, err
 	}
 
+	responseBody, err := c.apiRequestContext(ctx, "POST", "/api/v1/invitations", req, nil, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, target := range req.Targets {
+		c.invalidate(targetCacheKey(target.Type, target.Value))
+	}
+
 	var invitation InvitationResult
-	if err := json.Unmarshal(responseBody, &invitation); err != nil {
+	if err := c.decode(responseBody, &invitation); err != nil {
 		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
 	}
 
@@ -274,27 +732,73 @@ func (c *Client) GetInvitation(invitationID string) (*InvitationResult, error) {
 }
 
 // RevokeInvitation revokes an invitation
-func (c *Client) RevokeInvitation(invitationID string) error {
+func (c *Client) RevokeInvitation(invitationID string, opts ...RequestOption) error {
+	return c.RevokeInvitationContext(context.Background(), invitationID, opts...)
+}
+
+// RevokeInvitationContext is like RevokeInvitation but bound to ctx
+func (c *Client) RevokeInvitationContext(ctx context.Context, invitationID string, opts ...RequestOption) error {
 	path := fmt.Sprintf("/api/v1/invitations/%s", invitationID)
 
-	_, err := c.apiRequest("DELETE", path, nil, nil)
+	_, err := c.apiRequestContext(ctx, "DELETE", path, nil, nil, opts...)
+	if err == nil {
+		c.invalidateInvitation(invitationID)
+	}
 	return err
 }
 
 // AcceptInvitations accepts multiple invitations
-func (c *Client) AcceptInvitations(invitationIDs []string, target InvitationTarget) (*InvitationResult, error) {
-	requestBody := AcceptInvitationRequest{
+//
+// A random Idempotency-Key is sent unless one is given with IdempotencyKey.
+// The invitations are accepted in one request, which fails as a whole; use
+// AcceptInvitationsBatch to see which invitations failed.
+func (c *Client) AcceptInvitations(invitationIDs []string, target InvitationTarget, opts ...RequestOption) (*InvitationResult, error) {
+	return c.AcceptInvitationsContext(context.Background(), invitationIDs, target, opts...)
+}
+
+// AcceptInvitationsContext is like AcceptInvitations but bound to ctx
+func (c *Client) AcceptInvitationsContext(ctx context.Context, invitationIDs []string, target InvitationTarget, opts ...RequestOption) (*InvitationResult, error) {
+	return c.acceptInvitations(ctx, AcceptInvitationRequest{
 		InvitationIDs: invitationIDs,
 		Target:        target,
+	}, opts)
+}
+
+// AcceptInvitationsAsAdmin accepts invitations on behalf of the target,
+// recording the admin who performed the acceptance
+//
+// Example:
+//
+//	result, err := client.AcceptInvitationsAsAdmin(ctx, []string{"invitation-id"}, target, vortex.Actor{
+//	    ID:    "support-agent-1",
+//	    Email: "agent@example.com",
+//	})
+func (c *Client) AcceptInvitationsAsAdmin(ctx context.Context, invitationIDs []string, target InvitationTarget, actor Actor, opts ...RequestOption) (*InvitationResult, error) {
+	return c.acceptInvitations(ctx, AcceptInvitationRequest{
+		InvitationIDs: invitationIDs,
+		Target:        target,
+		Actor:         &actor,
+	}, opts)
+}
+
+func (c *Client) acceptInvitations(ctx context.Context, requestBody AcceptInvitationRequest, opts []RequestOption) (*InvitationResult, error) {
+	opts, err := withIdempotencyKey(ctx, opts)
+	if err != nil {
+		return nil, err
 	}
 
-	responseBody, err := c.apiRequest("POST", "/api/v1/invitations/accept", requestBody, nil)
+	responseBody, err := c.apiRequestContext(ctx, "POST", "/api/v1/invitations/accept", requestBody, nil, opts...)
 	if err != nil {
 		return nil, err
 	}
 
+	for _, invitationID := range requestBody.InvitationIDs {
+		c.invalidateInvitation(invitationID)
+	}
+	c.invalidate(targetCacheKey(requestBody.Target.Type, requestBody.Target.Value))
+
 	var result InvitationResult
-	if err := json.Unmarshal(responseBody, &result); err != nil {
+	if err := c.decode(responseBody, &result); err != nil {
 		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
 	}
 
@@ -302,43 +806,197 @@ func (c *Client) AcceptInvitations(invitationIDs []string, target InvitationTarg
 }
 
 // DeleteInvitationsByGroup deletes all invitations for a specific group
-func (c *Client) DeleteInvitationsByGroup(groupType, groupID string) error {
+func (c *Client) DeleteInvitationsByGroup(groupType, groupID string, opts ...RequestOption) error {
+	return c.DeleteInvitationsByGroupContext(context.Background(), groupType, groupID, opts...)
+}
+
+// DeleteInvitationsByGroupContext is like DeleteInvitationsByGroup but bound
+// to ctx
+func (c *Client) DeleteInvitationsByGroupContext(ctx context.Context, groupType, groupID string, opts ...RequestOption) error {
 	path := fmt.Sprintf("/api/v1/invitations/by-group/%s/%s", groupType, groupID)
 
-	_, err := c.apiRequest("DELETE", path, nil, nil)
+	_, err := c.apiRequestContext(ctx, "DELETE", path, nil, nil, opts...)
+	if err == nil && c.cache != nil {
+		// The deleted invitations are not known, so drop everything
+		c.cache.Clear()
+	}
 	return err
 }
 
 // GetInvitationsByGroup retrieves invitations for a specific group
-func (c *Client) GetInvitationsByGroup(groupType, groupID string) ([]InvitationResult, error) {
+func (c *Client) GetInvitationsByGroup(groupType, groupID string, opts ...ListOption) ([]InvitationResult, error) {
+	return c.GetInvitationsByGroupContext(context.Background(), groupType, groupID, opts...)
+}
+
+// GetInvitationsByGroupContext is like GetInvitationsByGroup but bound to ctx
+func (c *Client) GetInvitationsByGroupContext(ctx context.Context, groupType, groupID string, opts ...ListOption) ([]InvitationResult, error) {
 	path := fmt.Sprintf("/api/v1/invitations/by-group/%s/%s", groupType, groupID)
 
-	responseBody, err := c.apiRequest("GET", path, nil, nil)
+	return c.listInvitations(ctx, path, nil, opts)
+}
+
+// Reinvite sends a reinvitation for a specific invitation
+//
+// A random Idempotency-Key is sent unless one is given with IdempotencyKey.
+func (c *Client) Reinvite(invitationID string, opts ...RequestOption) (*InvitationResult, error) {
+	return c.ReinviteContext(context.Background(), invitationID, opts...)
+}
+
+// ReinviteContext is like Reinvite but bound to ctx
+func (c *Client) ReinviteContext(ctx context.Context, invitationID string, opts ...RequestOption) (*InvitationResult, error) {
+	path := fmt.Sprintf("/api/v1/invitations/%s/reinvite", invitationID)
+
+	opts, err := withIdempotencyKey(ctx, opts)
 	if err != nil {
 		return nil, err
 	}
 
-	var response InvitationsResponse
-	if err := json.Unmarshal(responseBody, &response); err != nil {
+	responseBody, err := c.apiRequestContext(ctx, "POST", path, nil, nil, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	c.invalidateInvitation(invitationID)
+
+	var result InvitationResult
+	if err := c.decode(responseBody, &result); err != nil {
 		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
 	}
 
-	return response.Invitations, nil
+	return &result, nil
 }
 
-// Reinvite sends a reinvitation for a specific invitation
-func (c *Client) Reinvite(invitationID string) (*InvitationResult, error) {
-	path := fmt.Sprintf("/api/v1/invitations/%s/reinvite", invitationID)
+// MoveInvitation moves a pending invitation from one group to another,
+// keeping its link and delivery history instead of revoking and reissuing it
+//
+// Example:
+//
+//	invitation, err := client.MoveInvitation(ctx, "invitation-id",
+//	    vortex.GroupRef{Type: "team", GroupID: "platform"},
+//	    vortex.GroupRef{Type: "team", GroupID: "infrastructure"})
+func (c *Client) MoveInvitation(ctx context.Context, invitationID string, fromGroup, toGroup GroupRef, opts ...RequestOption) (*InvitationResult, error) {
+	path := fmt.Sprintf("/api/v1/invitations/%s/move", invitationID)
 
-	responseBody, err := c.apiRequest("POST", path, nil, nil)
+	requestBody := map[string]GroupRef{
+		"from": fromGroup,
+		"to":   toGroup,
+	}
+
+	responseBody, err := c.apiRequestContext(ctx, "POST", path, requestBody, nil, opts...)
 	if err != nil {
 		return nil, err
 	}
 
+	c.invalidateInvitation(invitationID)
+
 	var result InvitationResult
-	if err := json.Unmarshal(responseBody, &result); err != nil {
+	if err := c.decode(responseBody, &result); err != nil {
 		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
 	}
 
 	return &result, nil
-}
\ No newline at end of file
+}
+
+// CloneInvitation creates a new invitation with the configuration of an
+// existing one, sent to the target given in overrides
+//
+// Example:
+//
+//	invitation, err := client.CloneInvitation(ctx, "invitation-id", vortex.InvitationOverrides{
+//	    Target: vortex.InvitationTarget{Type: "email", Value: "new@example.com"},
+//	})
+func (c *Client) CloneInvitation(ctx context.Context, invitationID string, overrides InvitationOverrides, opts ...RequestOption) (*InvitationResult, error) {
+	path := fmt.Sprintf("/api/v1/invitations/%s/clone", invitationID)
+
+	responseBody, err := c.apiRequestContext(ctx, "POST", path, overrides, nil, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	c.invalidate(targetCacheKey(overrides.Target.Type, overrides.Target.Value))
+
+	var result InvitationResult
+	if err := c.decode(responseBody, &result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	return &result, nil
+}
+
+// mergePatch is a JSON Merge Patch (RFC 7396) request body
+type mergePatch map[string]interface{}
+
+func (mergePatch) contentType() string {
+	return "application/merge-patch+json"
+}
+
+// PatchInvitationAttributes partially updates an invitation's attributes and
+// configuration attributes, leaving every other field untouched
+//
+// attrs is a JSON Merge Patch document whose top-level keys may only be
+// "attributes" and "configurationAttributes". Nested keys are merged into the
+// existing values, and keys set to nil are removed. Pass IfMatch to avoid
+// overwriting a concurrent edit.
+//
+// Example:
+//
+//	invitation, err := client.PatchInvitationAttributes(ctx, "invitation-id", map[string]interface{}{
+//	    "attributes": map[string]interface{}{
+//	        "plan":  "pro",
+//	        "trial":  nil, // removes "trial"
+//	    },
+//	})
+func (c *Client) PatchInvitationAttributes(ctx context.Context, invitationID string, attrs map[string]interface{}, opts ...RequestOption) (*InvitationResult, error) {
+	for key := range attrs {
+		if key != "attributes" && key != "configurationAttributes" {
+			return nil, fmt.Errorf("cannot patch invitation field %q: only attributes and configurationAttributes can be patched", key)
+		}
+	}
+
+	path := fmt.Sprintf("/api/v1/invitations/%s", invitationID)
+
+	responseBody, err := c.apiRequestContext(ctx, "PATCH", path, mergePatch(attrs), nil, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	c.invalidateInvitation(invitationID)
+
+	var result InvitationResult
+	if err := c.decode(responseBody, &result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	return &result, nil
+}
+
+// RevokeAllForTarget revokes every pending invitation sent to an email
+// address or phone number, across all groups, and invalidates their
+// outstanding accept links
+//
+// It is meant for responding to a compromised account. It returns the IDs of
+// the revoked invitations.
+func (c *Client) RevokeAllForTarget(ctx context.Context, target InvitationTarget, opts ...RequestOption) ([]string, error) {
+	requestBody := map[string]InvitationTarget{
+		"target": target,
+	}
+
+	responseBody, err := c.apiRequestContext(ctx, "POST", "/api/v1/invitations/revoke-by-target", requestBody, nil, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	var response struct {
+		InvitationIDs []string `json:"invitationIds"`
+	}
+	if err := c.decode(responseBody, &response); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	for _, invitationID := range response.InvitationIDs {
+		c.invalidateInvitation(invitationID)
+	}
+	c.invalidate(targetCacheKey(target.Type, target.Value))
+
+	return response.InvitationIDs, nil
+}
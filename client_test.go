@@ -1,10 +1,18 @@
 package vortex
 
 import (
+	"context"
+	"encoding/base64"
 	"encoding/json"
+	"errors"
 	"net/http"
 	"net/http/httptest"
+	"strings"
+	"sync"
 	"testing"
+	"time"
+
+	"github.com/TeamVortexSoftware/vortex-go-sdk/vortexjwt"
 )
 
 func TestNewClient(t *testing.T) {
@@ -32,6 +40,36 @@ func TestNewClientWithOptions(t *testing.T) {
 	}
 }
 
+func TestNewClient_FunctionalOptions(t *testing.T) {
+	var userAgent string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		userAgent = r.Header.Get("User-Agent")
+		w.Write([]byte(`{"id": "inv-123"}`))
+	}))
+	defer server.Close()
+
+	httpClient := &http.Client{}
+	client := NewClient("test-api-key",
+		WithBaseURL(server.URL),
+		WithHTTPClient(httpClient),
+		WithTimeout(5*time.Second),
+		WithUserAgent("billing-service/2.3"),
+	)
+
+	if _, err := client.GetInvitation("inv-123"); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if want := "billing-service/2.3 vortex-go-sdk/1.0.0"; userAgent != want {
+		t.Errorf("Expected User-Agent %q, got %q", want, userAgent)
+	}
+	if client.httpClient.Timeout != 5*time.Second {
+		t.Errorf("Expected timeout 5s, got %s", client.httpClient.Timeout)
+	}
+	if httpClient.Timeout != 0 {
+		t.Errorf("Expected the caller's HTTP client not to be changed, got timeout %s", httpClient.Timeout)
+	}
+}
+
 func TestGenerateJWT(t *testing.T) {
 	// Test with valid API key format (UUID 12345678-1234-1234-1234-123456789012 encoded in base64url)
 	client := NewClient("VRTX.EjRWeBI0EjQSNBI0VniQEg.test-key")
@@ -58,6 +96,50 @@ func TestGenerateJWT(t *testing.T) {
 	}
 }
 
+func TestGenerateJWT_CachesSigningKey(t *testing.T) {
+	client := NewClient("VRTX.EjRWeBI0EjQSNBI0VniQEg.test-key")
+
+	var wg sync.WaitGroup
+	keys := make(chan *vortexjwt.Key, 10)
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			key, err := client.signingKey()
+			if err != nil {
+				t.Errorf("Expected no error, got %v", err)
+			}
+			keys <- key
+		}()
+	}
+	wg.Wait()
+	close(keys)
+
+	first := <-keys
+	for key := range keys {
+		if key != first {
+			t.Fatal("Expected every call to share one derived key")
+		}
+	}
+	if userKey, _ := client.AsUser(&User{ID: "user-1"}).signingKey(); userKey != first {
+		t.Error("Expected AsUser clients to share the derived key")
+	}
+}
+
+func BenchmarkGenerateJWT(b *testing.B) {
+	client := NewClient("VRTX.EjRWeBI0EjQSNBI0VniQEg.test-key")
+	user := &User{ID: "user-123", Email: "test@example.com"}
+
+	b.ReportAllocs()
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			if _, err := client.GenerateJWT(user, nil); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
+
 func TestGenerateJWT_WithExtra(t *testing.T) {
 	client := NewClient("VRTX.EjRWeBI0EjQSNBI0VniQEg.test-key")
 
@@ -113,6 +195,148 @@ func TestGenerateJWT_InvalidAPIKey(t *testing.T) {
 	}
 }
 
+func TestVerifyJWT(t *testing.T) {
+	client := NewClient("VRTX.EjRWeBI0EjQSNBI0VniQEg.test-key")
+
+	user := &User{
+		ID:          "user-123",
+		Email:       "test@example.com",
+		AdminScopes: []string{"autojoin"},
+	}
+
+	jwt, err := client.GenerateJWT(user, nil)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	claims, err := client.VerifyJWT(jwt)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if claims.UserID != "user-123" {
+		t.Errorf("Expected userId to be 'user-123', got %s", claims.UserID)
+	}
+	if claims.UserEmail != "test@example.com" {
+		t.Errorf("Expected userEmail to be 'test@example.com', got %s", claims.UserEmail)
+	}
+	if len(claims.AdminScopes) != 1 || claims.AdminScopes[0] != "autojoin" {
+		t.Errorf("Expected adminScopes to be [autojoin], got %v", claims.AdminScopes)
+	}
+}
+
+func TestVerifyJWT_Invalid(t *testing.T) {
+	client := NewClient("VRTX.EjRWeBI0EjQSNBI0VniQEg.test-key")
+	other := NewClient("VRTX.EjRWeBI0EjQSNBI0VniQEg.other-key")
+	user := &User{ID: "user-123", Email: "test@example.com"}
+
+	otherJWT, err := other.GenerateJWT(user, nil)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	expiredJWT, err := client.GenerateJWTWithOptions(user, nil, JWTOptions{IssuedAt: time.Now().Add(-2 * time.Hour)})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	tests := []struct {
+		name  string
+		token string
+	}{
+		{"malformed", "not-a-jwt"},
+		{"wrong signing key", otherJWT},
+		{"expired", expiredJWT},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := client.VerifyJWT(tt.token); err == nil {
+				t.Error("Expected error for invalid JWT")
+			}
+		})
+	}
+}
+
+func TestVerifyJWT_Expired(t *testing.T) {
+	client := NewClient("VRTX.EjRWeBI0EjQSNBI0VniQEg.test-key")
+
+	expiredJWT, err := client.GenerateJWTWithOptions(&User{ID: "user-123"}, nil, JWTOptions{IssuedAt: time.Now().Add(-2 * time.Hour)})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if _, err := client.VerifyJWT(expiredJWT); !errors.Is(err, ErrJWTExpired) {
+		t.Errorf("Expected ErrJWTExpired, got %v", err)
+	}
+}
+
+func TestParseJWT(t *testing.T) {
+	other := NewClient("VRTX.EjRWeBI0EjQSNBI0VniQEg.other-key")
+
+	jwt, err := other.GenerateJWT(&User{ID: "user-123", Email: "test@example.com"}, nil)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	// Parsing does not need the signing key
+	claims, err := ParseJWT(jwt)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if claims.UserID != "user-123" {
+		t.Errorf("Expected userId to be 'user-123', got %s", claims.UserID)
+	}
+
+	if _, err := ParseJWT("not-a-jwt"); err == nil {
+		t.Error("Expected error for malformed JWT")
+	}
+}
+
+func TestGenerateJWTWithOptions(t *testing.T) {
+	client := NewClient("VRTX.EjRWeBI0EjQSNBI0VniQEg.test-key")
+	user := &User{ID: "user-123", Email: "test@example.com"}
+	issuedAt := time.Now().Truncate(time.Second)
+
+	jwt, err := client.GenerateJWTWithOptions(user, nil, JWTOptions{
+		TTL:       5 * time.Minute,
+		NotBefore: issuedAt,
+		IssuedAt:  issuedAt,
+		Leeway:    30 * time.Second,
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	claims, err := client.VerifyJWT(jwt)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if want := issuedAt.Add(5 * time.Minute).Unix(); claims.Expires != want {
+		t.Errorf("Expected expires %d, got %d", want, claims.Expires)
+	}
+	if want := issuedAt.Add(-30 * time.Second).Unix(); claims.NotBefore != want {
+		t.Errorf("Expected notBefore %d, got %d", want, claims.NotBefore)
+	}
+
+	var header JWTHeader
+	headerJSON, _ := base64.RawURLEncoding.DecodeString(strings.Split(jwt, ".")[0])
+	if err := json.Unmarshal(headerJSON, &header); err != nil {
+		t.Fatalf("Failed to unmarshal header: %v", err)
+	}
+	if want := issuedAt.Add(-30 * time.Second).Unix(); header.IAT != want {
+		t.Errorf("Expected iat %d, got %d", want, header.IAT)
+	}
+
+	future, err := client.GenerateJWTWithOptions(user, nil, JWTOptions{NotBefore: time.Now().Add(time.Hour)})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if _, err := client.VerifyJWT(future); !errors.Is(err, ErrJWTNotYetValid) {
+		t.Errorf("Expected ErrJWTNotYetValid, got %v", err)
+	}
+}
+
 func TestAPIRequest_Success(t *testing.T) {
 	// Create mock server
 	mockResponse := InvitationsResponse{
@@ -183,6 +407,10 @@ func TestAPIRequest_Error(t *testing.T) {
 	if apiErr.StatusCode != 404 {
 		t.Errorf("Expected status code 404, got %d", apiErr.StatusCode)
 	}
+
+	if apiErr.Method != "GET" || apiErr.Path != "/api/v1/invitations" {
+		t.Errorf("Expected error for GET /api/v1/invitations, got %s %s", apiErr.Method, apiErr.Path)
+	}
 }
 
 func TestGetInvitation(t *testing.T) {
@@ -213,6 +441,83 @@ func TestGetInvitation(t *testing.T) {
 	}
 }
 
+func TestGetInvitationContext_Cancelled(t *testing.T) {
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-release
+	}))
+	defer server.Close()
+	defer close(release)
+
+	client := NewClientWithOptions("test-api-key", server.URL, nil)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	_, err := client.GetInvitationContext(ctx, "test-invitation-1")
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("Expected context.DeadlineExceeded, got %v", err)
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("Expected the call to return at the deadline, took %s", elapsed)
+	}
+}
+
+func TestCreateInvitation(t *testing.T) {
+	var received CreateInvitationRequest
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "POST" || r.URL.Path != "/api/v1/invitations" {
+			t.Errorf("Expected POST /api/v1/invitations, got %s %s", r.Method, r.URL.Path)
+		}
+		if err := json.NewDecoder(r.Body).Decode(&received); err != nil {
+			t.Errorf("Failed to decode request body: %v", err)
+		}
+		json.NewEncoder(w).Encode(InvitationResult{
+			ID:     "inv-new",
+			Status: "queued",
+			Target: received.Targets,
+			Locale: received.Locale,
+		})
+	}))
+	defer server.Close()
+
+	client := NewClientWithOptions("test-api-key", server.URL, nil)
+
+	invitation, err := client.CreateInvitation(context.Background(), CreateInvitationRequest{
+		WidgetConfigurationID: "wc-1",
+		Targets:               []InvitationTarget{{Type: "email", Value: "user@example.com"}},
+		InviterID:             "user-123",
+		Groups:                []GroupRef{{Type: "workspace", GroupID: "ws-123"}},
+		DeliveryTypes:         []DeliveryType{DeliveryEmail},
+		Attributes:            map[string]interface{}{"plan": "pro"},
+		Expires:               ExpiryTime(time.Date(2026, 12, 1, 17, 0, 0, 0, time.UTC)),
+		DeepLink:              &DeepLink{FallbackURL: "https://example.com/join"},
+		VariantID:             "variant-b",
+		Locale:                "fr-CA",
+		Referral:              &Referral{ReferrerID: "user-9", Campaign: "spring"},
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if invitation.ID != "inv-new" || invitation.Locale != "fr-CA" {
+		t.Errorf("Expected created invitation inv-new in fr-CA, got %+v", invitation)
+	}
+	if received.WidgetConfigurationID != "wc-1" || received.InviterID != "user-123" {
+		t.Errorf("Expected widget configuration and inviter to be sent, got %+v", received)
+	}
+	if len(received.Groups) != 1 || received.Groups[0].GroupID != "ws-123" {
+		t.Errorf("Expected group ws-123 to be sent, got %v", received.Groups)
+	}
+	if received.Referral == nil || received.Referral.Campaign != "spring" {
+		t.Errorf("Expected referral to be sent, got %v", received.Referral)
+	}
+	if received.DeepLink == nil || received.VariantID != "variant-b" {
+		t.Errorf("Expected deep link and variant to be sent, got %+v", received)
+	}
+}
+
 func TestRevokeInvitation(t *testing.T) {
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		if r.Method != "DELETE" {
@@ -286,6 +591,37 @@ func TestAcceptInvitations(t *testing.T) {
 	}
 }
 
+func TestAcceptInvitationsAsAdmin(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req AcceptInvitationRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Errorf("Failed to decode request body: %v", err)
+		}
+
+		if req.Actor == nil || req.Actor.ID != "admin-1" {
+			t.Errorf("Expected actor 'admin-1', got %+v", req.Actor)
+		}
+		if req.Target.Value != "stuck@example.com" {
+			t.Errorf("Expected target 'stuck@example.com', got %s", req.Target.Value)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(InvitationResult{ID: "inv1", Status: "accepted"})
+	}))
+	defer server.Close()
+
+	client := NewClientWithOptions("test-api-key", server.URL, nil)
+	target := InvitationTarget{Type: "email", Value: "stuck@example.com"}
+
+	result, err := client.AcceptInvitationsAsAdmin(context.Background(), []string{"inv1"}, target, Actor{ID: "admin-1", Email: "admin@example.com"})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if result.Status != "accepted" {
+		t.Errorf("Expected status 'accepted', got %s", result.Status)
+	}
+}
+
 // Helper functions for tests
 func stringPtr(s string) *string {
 	return &s
@@ -309,4 +645,194 @@ func splitJWT(jwt string) []string {
 	}
 
 	return parts
-}
\ No newline at end of file
+}
+
+func TestMoveInvitation(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "POST" || r.URL.Path != "/api/v1/invitations/inv1/move" {
+			t.Errorf("Expected POST /api/v1/invitations/inv1/move, got %s %s", r.Method, r.URL.Path)
+		}
+
+		var req map[string]GroupRef
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Errorf("Failed to decode request body: %v", err)
+		}
+		if req["from"].GroupID != "platform" || req["to"].GroupID != "infrastructure" {
+			t.Errorf("Expected move from 'platform' to 'infrastructure', got %+v", req)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(InvitationResult{
+			ID:     "inv1",
+			Status: "pending",
+			Groups: []InvitationGroup{{Type: "team", GroupID: "infrastructure"}},
+		})
+	}))
+	defer server.Close()
+
+	client := NewClientWithOptions("test-api-key", server.URL, nil)
+
+	result, err := client.MoveInvitation(context.Background(), "inv1",
+		GroupRef{Type: "team", GroupID: "platform"},
+		GroupRef{Type: "team", GroupID: "infrastructure"})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if len(result.Groups) != 1 || result.Groups[0].GroupID != "infrastructure" {
+		t.Errorf("Expected invitation in group 'infrastructure', got %+v", result.Groups)
+	}
+}
+
+func TestCloneInvitation(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "POST" || r.URL.Path != "/api/v1/invitations/inv1/clone" {
+			t.Errorf("Expected POST /api/v1/invitations/inv1/clone, got %s %s", r.Method, r.URL.Path)
+		}
+
+		var req map[string]interface{}
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Errorf("Failed to decode request body: %v", err)
+		}
+		if _, ok := req["groups"]; ok {
+			t.Error("Expected unset overrides to be omitted")
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(InvitationResult{
+			ID:     "inv2",
+			Target: []InvitationTarget{{Type: "email", Value: "new@example.com"}},
+		})
+	}))
+	defer server.Close()
+
+	client := NewClientWithOptions("test-api-key", server.URL, nil)
+
+	result, err := client.CloneInvitation(context.Background(), "inv1", InvitationOverrides{
+		Target: InvitationTarget{Type: "email", Value: "new@example.com"},
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if result.ID != "inv2" {
+		t.Errorf("Expected cloned invitation 'inv2', got %s", result.ID)
+	}
+}
+
+func TestPatchInvitationAttributes(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "PATCH" || r.URL.Path != "/api/v1/invitations/inv1" {
+			t.Errorf("Expected PATCH /api/v1/invitations/inv1, got %s %s", r.Method, r.URL.Path)
+		}
+		if r.Header.Get("Content-Type") != "application/merge-patch+json" {
+			t.Errorf("Expected merge patch content type, got %s", r.Header.Get("Content-Type"))
+		}
+
+		var req map[string]map[string]interface{}
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Errorf("Failed to decode request body: %v", err)
+		}
+		trial, ok := req["attributes"]["trial"]
+		if !ok || trial != nil {
+			t.Errorf("Expected 'trial' to be sent as null, got %v", req["attributes"])
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(InvitationResult{
+			ID:         "inv1",
+			Attributes: map[string]interface{}{"plan": "pro"},
+		})
+	}))
+	defer server.Close()
+
+	client := NewClientWithOptions("test-api-key", server.URL, nil)
+
+	result, err := client.PatchInvitationAttributes(context.Background(), "inv1", map[string]interface{}{
+		"attributes": map[string]interface{}{"plan": "pro", "trial": nil},
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if result.Attributes["plan"] != "pro" {
+		t.Errorf("Expected plan 'pro', got %v", result.Attributes["plan"])
+	}
+}
+
+func TestPatchInvitationAttributes_OtherFields(t *testing.T) {
+	client := NewClientWithOptions("test-api-key", "http://localhost:0", nil)
+
+	_, err := client.PatchInvitationAttributes(context.Background(), "inv1", map[string]interface{}{
+		"status": "accepted",
+	})
+	if err == nil {
+		t.Error("Expected an error when patching fields other than attributes")
+	}
+}
+
+func TestRevokeAllForTarget(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if r.Method == "GET" {
+			w.Write([]byte(`{"invitations": [{"id": "inv1"}, {"id": "inv2"}]}`))
+			return
+		}
+		if r.Method != "POST" || r.URL.Path != "/api/v1/invitations/revoke-by-target" {
+			t.Errorf("Expected POST /api/v1/invitations/revoke-by-target, got %s %s", r.Method, r.URL.Path)
+		}
+
+		var req map[string]InvitationTarget
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Errorf("Failed to decode request body: %v", err)
+		}
+		if req["target"].Value != "victim@example.com" {
+			t.Errorf("Expected target 'victim@example.com', got %+v", req["target"])
+		}
+
+		w.Write([]byte(`{"invitationIds": ["inv1", "inv2"]}`))
+	}))
+	defer server.Close()
+
+	client := NewClientWithOptions("test-api-key", server.URL, nil, WithCache(NewLRUCache(10, time.Minute)))
+	target := InvitationTarget{Type: "email", Value: "victim@example.com"}
+
+	if _, err := client.GetInvitationsForTarget(context.Background(), target); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	revoked, err := client.RevokeAllForTarget(context.Background(), target)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if len(revoked) != 2 {
+		t.Errorf("Expected 2 revoked invitations, got %v", revoked)
+	}
+
+	if _, err := client.GetInvitationsForTarget(context.Background(), target); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if requests != 3 {
+		t.Errorf("Expected the target lookup to be invalidated (3 requests), got %d", requests)
+	}
+}
+
+func TestWithMaxResponseSize(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"id": "inv1", "status": "pending"}`))
+	}))
+	defer server.Close()
+
+	client := NewClientWithOptions("test-api-key", server.URL, nil, WithMaxResponseSize(16))
+	if _, err := client.GetInvitation("inv1"); !errors.Is(err, ErrResponseTooLarge) {
+		t.Errorf("Expected ErrResponseTooLarge, got %v", err)
+	}
+
+	client = NewClientWithOptions("test-api-key", server.URL, nil, WithMaxResponseSize(64))
+	if _, err := client.GetInvitation("inv1"); err != nil {
+		t.Errorf("Expected no error within the limit, got %v", err)
+	}
+
+	client = NewClientWithOptions("test-api-key", server.URL, nil, WithMaxResponseSize(0))
+	if _, err := client.GetInvitation("inv1"); err != nil {
+		t.Errorf("Expected no error without a limit, got %v", err)
+	}
+}
@@ -4,20 +4,68 @@
 package main
 
 import (
+	"encoding/json"
+	"flag"
 	"fmt"
 	"os"
 
-	vortex "github.com/teamvortexsoftware/vortex-go-sdk"
+	vortex "github.com/TeamVortexSoftware/vortex-go-sdk"
 )
 
+// Exit codes are part of the command's output contract and must stay stable
+const (
+	// ExitOK means all JWTs were generated
+	ExitOK = 0
+	// ExitError means JWT generation failed (e.g. a malformed API key)
+	ExitError = 1
+	// ExitUsage means the command was invoked with invalid arguments
+	ExitUsage = 2
+)
+
+// Output formats accepted by --output
+const (
+	outputText = "text"
+	outputJSON = "json"
+)
+
+// result is the --output json contract; field names must stay stable
+type result struct {
+	WithAdmin string `json:"withAdmin,omitempty"`
+	NoAdmin   string `json:"noAdmin,omitempty"`
+	WithExtra string `json:"withExtra,omitempty"`
+	Error     string `json:"error,omitempty"`
+	ExitCode  int    `json:"exitCode"`
+}
+
 func main() {
-	if len(os.Args) < 2 {
-		fmt.Fprintln(os.Stderr, "Usage: go run test_jwt_main.go <api-key>")
-		os.Exit(1)
+	output := flag.String("output", outputText, "output format: text or json")
+	flag.Usage = func() {
+		fmt.Fprintln(os.Stderr, "Usage: test-jwt [--output text|json] <api-key>")
+	}
+	flag.Parse()
+
+	if flag.NArg() < 1 || (*output != outputText && *output != outputJSON) {
+		flag.Usage()
+		os.Exit(ExitUsage)
 	}
 
-	apiKey := os.Args[1]
-	client := vortex.NewClient(apiKey)
+	res := generate(vortex.NewClient(flag.Arg(0)))
+
+	if *output == outputJSON {
+		json.NewEncoder(os.Stdout).Encode(res)
+	} else if res.Error != "" {
+		fmt.Fprintln(os.Stderr, res.Error)
+	} else {
+		fmt.Printf("WITH_ADMIN:%s\n", res.WithAdmin)
+		fmt.Printf("NO_ADMIN:%s\n", res.NoAdmin)
+		fmt.Printf("WITH_EXTRA:%s\n", res.WithExtra)
+	}
+	os.Exit(res.ExitCode)
+}
+
+func generate(client *vortex.Client) result {
+	var res result
+	var err error
 
 	// Test with admin scope
 	userWithAdmin := &vortex.User{
@@ -25,34 +73,34 @@ func main() {
 		Email:       "test@example.com",
 		AdminScopes: []string{"autojoin"},
 	}
-	jwtWithAdmin, err := client.GenerateJWT(userWithAdmin, nil)
+	res.WithAdmin, err = client.GenerateJWT(userWithAdmin, nil)
 	if err != nil {
-		fmt.Fprintf(os.Stderr, "Error generating JWT (with admin): %v\n", err)
-		os.Exit(1)
+		return failed(fmt.Errorf("Error generating JWT (with admin): %s", vortex.ExplainError(err)))
 	}
-	fmt.Printf("WITH_ADMIN:%s\n", jwtWithAdmin)
 
 	// Test without admin scope
 	userNoAdmin := &vortex.User{
 		ID:    "test-user-123",
 		Email: "test@example.com",
 	}
-	jwtNoAdmin, err := client.GenerateJWT(userNoAdmin, nil)
+	res.NoAdmin, err = client.GenerateJWT(userNoAdmin, nil)
 	if err != nil {
-		fmt.Fprintf(os.Stderr, "Error generating JWT (simple no admin): %v\n", err)
-		os.Exit(1)
+		return failed(fmt.Errorf("Error generating JWT (simple no admin): %s", vortex.ExplainError(err)))
 	}
-	fmt.Printf("NO_ADMIN:%s\n", jwtNoAdmin)
 
 	// Test with extra properties
 	extra := map[string]interface{}{
 		"role":       "admin",
 		"department": "Engineering",
 	}
-	jwtExtra, err := client.GenerateJWT(userWithAdmin, extra)
+	res.WithExtra, err = client.GenerateJWT(userWithAdmin, extra)
 	if err != nil {
-		fmt.Fprintf(os.Stderr, "Error generating JWT (with extra): %v\n", err)
-		os.Exit(1)
+		return failed(fmt.Errorf("Error generating JWT (with extra): %s", vortex.ExplainError(err)))
 	}
-	fmt.Printf("WITH_EXTRA:%s\n", jwtExtra)
+
+	return res
+}
+
+func failed(err error) result {
+	return result{Error: err.Error(), ExitCode: ExitError}
 }
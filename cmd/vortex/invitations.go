@@ -0,0 +1,158 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	vortex "github.com/TeamVortexSoftware/vortex-go-sdk"
+)
+
+func listInvitations(env *environment, args []string) error {
+	fs := env.flags("invitations list", "")
+	target := fs.String("target", "", "list a target's invitations, as type=value (e.g. email=ada@example.com)")
+	group := fs.String("group", "", "list a group's invitations, as type=id (e.g. team=team-1)")
+	status := fs.String("status", "", "comma-separated statuses to include (e.g. pending,delivered)")
+	limit := fs.Int("limit", 100, "maximum number of invitations to list, 0 for all")
+	if err := env.parse(fs, args, 0, 0); err != nil {
+		return err
+	}
+	if *target != "" && *group != "" {
+		return usagef("--target and --group cannot be combined")
+	}
+
+	var opts vortex.ListOptions
+	if *target != "" {
+		targetType, value, ok := strings.Cut(*target, "=")
+		if !ok {
+			return usagef("invalid --target %q: must be type=value", *target)
+		}
+		parsed, err := vortex.ParseTargetType(targetType)
+		if err != nil {
+			return usagef("invalid --target %q: %v", *target, err)
+		}
+		opts.TargetType, opts.TargetValue = parsed, value
+	}
+	if *group != "" {
+		groupType, groupID, ok := strings.Cut(*group, "=")
+		if !ok {
+			return usagef("invalid --group %q: must be type=id", *group)
+		}
+		opts.GroupType, opts.GroupID = groupType, groupID
+	}
+	if *status != "" {
+		for _, s := range strings.Split(*status, ",") {
+			opts.Status = append(opts.Status, vortex.InvitationStatus(strings.TrimSpace(s)))
+		}
+	}
+	if *limit > 0 && *limit < vortex.DefaultPageSize {
+		opts.PageSize = *limit
+	}
+
+	invitations := []vortex.InvitationResult{}
+	it := env.client().ListInvitations(context.Background(), opts)
+	for (*limit <= 0 || len(invitations) < *limit) && it.Next() {
+		invitations = append(invitations, it.Invitation())
+	}
+	if err := it.Err(); err != nil {
+		return err
+	}
+
+	if env.output == outputJSON {
+		return env.writeJSON(invitations)
+	}
+	return env.writeInvitations(invitations...)
+}
+
+func getInvitation(env *environment, args []string) error {
+	fs := env.flags("invitations get", "<id>")
+	if err := env.parse(fs, args, 1, 1); err != nil {
+		return err
+	}
+
+	invitation, err := env.client().GetInvitationContext(context.Background(), fs.Arg(0))
+	if err != nil {
+		return err
+	}
+
+	if env.output == outputJSON {
+		return env.writeJSON(invitation)
+	}
+	return env.writeInvitations(*invitation)
+}
+
+func revokeInvitations(env *environment, args []string) error {
+	fs := env.flags("invitations revoke", "<id>...")
+	if err := env.parse(fs, args, 1, -1); err != nil {
+		return err
+	}
+
+	result, err := env.client().RevokeInvitations(context.Background(), fs.Args())
+	if env.output == outputJSON {
+		failed := make(map[string]string, len(result.Failed))
+		for id, err := range result.Failed {
+			failed[id] = vortex.ExplainError(err)
+		}
+		output := map[string]interface{}{"revoked": result.Succeeded, "failed": failed}
+		if err == nil {
+			return env.writeJSON(output)
+		}
+		// The failures are reported in the same object rather than a second one
+		output["error"], output["exitCode"] = vortex.ExplainError(err), ExitError
+		if writeErr := env.writeJSON(output); writeErr != nil {
+			return writeErr
+		}
+		return reportedError{err}
+	}
+
+	for _, id := range result.Succeeded {
+		fmt.Fprintf(env.stdout, "revoked %s\n", id)
+	}
+	for _, id := range fs.Args() {
+		if err, ok := result.Failed[id]; ok {
+			fmt.Fprintf(env.stderr, "failed to revoke %s: %s\n", id, vortex.ExplainError(err))
+		}
+	}
+	return err
+}
+
+func reinvite(env *environment, args []string) error {
+	fs := env.flags("invitations reinvite", "<id>")
+	if err := env.parse(fs, args, 1, 1); err != nil {
+		return err
+	}
+
+	invitation, err := env.client().ReinviteContext(context.Background(), fs.Arg(0))
+	if err != nil {
+		return err
+	}
+
+	if env.output == outputJSON {
+		return env.writeJSON(invitation)
+	}
+	return env.writeInvitations(*invitation)
+}
+
+// writeInvitations writes invitations as a table
+func (env *environment) writeInvitations(invitations ...vortex.InvitationResult) error {
+	w := tabwriter.NewWriter(env.stdout, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(w, "ID\tSTATUS\tTARGET\tGROUPS\tCREATED")
+	for _, inv := range invitations {
+		targets := make([]string, len(inv.Target))
+		for i, target := range inv.Target {
+			targets[i] = fmt.Sprintf("%s=%s", target.Type, target.Value)
+		}
+		groups := make([]string, len(inv.Groups))
+		for i, group := range inv.Groups {
+			groups[i] = fmt.Sprintf("%s=%s", group.Type, group.GroupID)
+		}
+		created := ""
+		if !inv.CreatedAt.IsZero() {
+			created = inv.CreatedAt.Format(time.RFC3339)
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", inv.ID, inv.Status, strings.Join(targets, ","), strings.Join(groups, ","), created)
+	}
+	return w.Flush()
+}
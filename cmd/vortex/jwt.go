@@ -0,0 +1,38 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	vortex "github.com/TeamVortexSoftware/vortex-go-sdk"
+)
+
+func generateJWT(env *environment, args []string) error {
+	fs := env.flags("jwt generate", "")
+	userID := fs.String("user-id", "", "ID of the user the token is for (required)")
+	email := fs.String("email", "", "email of the user")
+	adminScopes := fs.String("admin-scopes", "", "comma-separated admin scopes (e.g. autojoin)")
+	ttl := fs.Duration("ttl", time.Hour, "how long the token is valid for")
+	if err := env.parse(fs, args, 0, 0); err != nil {
+		return err
+	}
+	if *userID == "" {
+		return usagef("missing --user-id")
+	}
+
+	user := &vortex.User{ID: *userID, Email: *email}
+	if *adminScopes != "" {
+		user.AdminScopes = strings.Split(*adminScopes, ",")
+	}
+	token, err := env.client().GenerateJWTWithOptions(user, nil, vortex.JWTOptions{TTL: *ttl})
+	if err != nil {
+		return err
+	}
+
+	if env.output == outputJSON {
+		return env.writeJSON(map[string]string{"token": token})
+	}
+	fmt.Fprintln(env.stdout, token)
+	return nil
+}
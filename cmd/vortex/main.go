@@ -0,0 +1,207 @@
+// Command vortex manages Vortex invitations and JWTs from the command line
+//
+//	vortex invitations list --target email=ada@example.com
+//	vortex invitations get <id>
+//	vortex invitations revoke <id>...
+//	vortex invitations reinvite <id>
+//	vortex jwt generate --user-id user-123 --email ada@example.com
+//
+// The API key is read from --api-key or the VORTEX_API_KEY environment
+// variable. Every command takes --output table (the default) or json. With
+// json, a failed command writes {"error": "...", "exitCode": n} to stdout.
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	vortex "github.com/TeamVortexSoftware/vortex-go-sdk"
+)
+
+// Exit codes are part of the command's output contract and must stay stable
+const (
+	// ExitOK means the command succeeded
+	ExitOK = 0
+	// ExitError means the command failed, e.g. the API rejected the request
+	ExitError = 1
+	// ExitUsage means the command was invoked with invalid arguments
+	ExitUsage = 2
+)
+
+// Output formats accepted by --output
+const (
+	outputTable = "table"
+	outputJSON  = "json"
+)
+
+const usage = `Usage: vortex <command> <subcommand> [flags] [args]
+
+Commands:
+  invitations list      List invitations by target or group
+  invitations get       Show an invitation
+  invitations revoke    Revoke invitations
+  invitations reinvite  Resend an invitation
+  jwt generate          Generate a JWT for a user
+
+Run "vortex <command> <subcommand> --help" for a command's flags.
+`
+
+// usageError reports invalid arguments
+type usageError struct {
+	msg string
+	// printed is set when the message is already on stderr, e.g. from the
+	// flag package
+	printed bool
+}
+
+func (e *usageError) Error() string {
+	return e.msg
+}
+
+// usagef returns a usageError with a formatted message
+func usagef(format string, args ...interface{}) error {
+	return &usageError{msg: fmt.Sprintf(format, args...)}
+}
+
+// reportedError is an error the command already included in its JSON output
+type reportedError struct {
+	error
+}
+
+func (e reportedError) Unwrap() error {
+	return e.error
+}
+
+// errorOutput is written to stdout when a command fails with --output json,
+// like the output of cmd/test-jwt
+type errorOutput struct {
+	Error    string `json:"error"`
+	ExitCode int    `json:"exitCode"`
+}
+
+// command is one subcommand; it returns a usageError for invalid arguments
+type command func(env *environment, args []string) error
+
+var commands = map[string]map[string]command{
+	"invitations": {
+		"list":     listInvitations,
+		"get":      getInvitation,
+		"revoke":   revokeInvitations,
+		"reinvite": reinvite,
+	},
+	"jwt": {
+		"generate": generateJWT,
+	},
+}
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdout, os.Stderr))
+}
+
+// run executes the command in args and returns its exit code
+func run(args []string, stdout, stderr io.Writer) int {
+	env := &environment{stdout: stdout, stderr: stderr, output: outputFlag(args)}
+	var err error
+	if len(args) < 2 || commands[args[0]][args[1]] == nil {
+		fmt.Fprint(stderr, usage)
+		err = &usageError{msg: "unknown command", printed: true}
+	} else {
+		err = commands[args[0]][args[1]](env, args[2:])
+	}
+	if err == nil {
+		return ExitOK
+	}
+
+	code, message := ExitError, vortex.ExplainError(err)
+	var usageErr *usageError
+	if errors.As(err, &usageErr) {
+		code, message = ExitUsage, usageErr.msg
+	}
+
+	var reported reportedError
+	switch {
+	case errors.As(err, &reported):
+	case env.output == outputJSON:
+		env.writeJSON(errorOutput{Error: message, ExitCode: code})
+	case usageErr != nil && usageErr.printed:
+	case usageErr != nil:
+		fmt.Fprintln(stderr, message)
+	default:
+		fmt.Fprintln(stderr, "Error:", message)
+	}
+	return code
+}
+
+// outputFlag returns the --output value in args, for errors reported before
+// or while the flags are parsed
+func outputFlag(args []string) string {
+	for i, arg := range args {
+		name := strings.TrimLeft(arg, "-")
+		if name == "output" && i+1 < len(args) {
+			return args[i+1]
+		}
+		if strings.HasPrefix(name, "output=") {
+			return strings.TrimPrefix(name, "output=")
+		}
+	}
+	return outputTable
+}
+
+// environment holds the flags every command shares and where output goes
+type environment struct {
+	stdout, stderr io.Writer
+
+	apiKey  string
+	baseURL string
+	output  string
+}
+
+// flags returns a flag set with the shared flags registered
+func (env *environment) flags(name, argsUsage string) *flag.FlagSet {
+	fs := flag.NewFlagSet(name, flag.ContinueOnError)
+	fs.SetOutput(env.stderr)
+	fs.StringVar(&env.apiKey, "api-key", os.Getenv("VORTEX_API_KEY"), "API key (default $VORTEX_API_KEY)")
+	fs.StringVar(&env.baseURL, "base-url", "", "API base URL (default $VORTEX_API_BASE_URL or the production API)")
+	fs.StringVar(&env.output, "output", outputTable, "output format: table or json")
+	fs.Usage = func() {
+		fmt.Fprintf(env.stderr, "Usage: vortex %s [flags] %s\n\nFlags:\n", name, argsUsage)
+		fs.PrintDefaults()
+	}
+	return fs
+}
+
+// parse parses args with fs and checks the shared flags and the number of
+// positional arguments
+func (env *environment) parse(fs *flag.FlagSet, args []string, minArgs, maxArgs int) error {
+	if err := fs.Parse(args); err != nil {
+		return &usageError{msg: err.Error(), printed: true}
+	}
+	if env.output != outputTable && env.output != outputJSON {
+		return usagef("invalid --output %q: must be table or json", env.output)
+	}
+	if fs.NArg() < minArgs || (maxArgs >= 0 && fs.NArg() > maxArgs) {
+		fs.Usage()
+		return &usageError{msg: "wrong number of arguments", printed: true}
+	}
+	if env.apiKey == "" {
+		return usagef("missing API key: set --api-key or VORTEX_API_KEY")
+	}
+	return nil
+}
+
+// client creates a client from the shared flags
+func (env *environment) client() *vortex.Client {
+	return vortex.NewClient(env.apiKey, vortex.WithBaseURL(env.baseURL), vortex.WithUserAgent("vortex-cli"))
+}
+
+// writeJSON writes v as indented JSON
+func (env *environment) writeJSON(v interface{}) error {
+	encoder := json.NewEncoder(env.stdout)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(v)
+}
@@ -0,0 +1,141 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	vortex "github.com/TeamVortexSoftware/vortex-go-sdk"
+)
+
+const testAPIKey = "VRTX.EjRWeBI0EjQSNBI0VniQEg.test-key"
+
+func runCLI(args ...string) (code int, stdout, stderr string) {
+	var out, errOut bytes.Buffer
+	code = run(args, &out, &errOut)
+	return code, out.String(), errOut.String()
+}
+
+func TestInvitationsList(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("x-api-key") != testAPIKey {
+			t.Errorf("Expected the API key from the flag, got %s", r.Header.Get("x-api-key"))
+		}
+		query := r.URL.Query()
+		if query.Get("targetType") != "email" || query.Get("targetValue") != "ada@example.com" {
+			t.Errorf("Unexpected query %s", r.URL.RawQuery)
+		}
+		w.Write([]byte(`{"invitations": [{"id": "inv-1", "status": "pending", "target": [{"type": "email", "value": "ada@example.com"}]}]}`))
+	}))
+	defer server.Close()
+
+	code, stdout, stderr := runCLI("invitations", "list", "--api-key", testAPIKey, "--base-url", server.URL,
+		"--target", "email=ada@example.com")
+	if code != ExitOK {
+		t.Fatalf("Expected exit code 0, got %d: %s", code, stderr)
+	}
+	if !strings.Contains(stdout, "inv-1") || !strings.Contains(stdout, "email=ada@example.com") {
+		t.Errorf("Expected the invitation in the table, got %q", stdout)
+	}
+
+	code, stdout, _ = runCLI("invitations", "list", "--api-key", testAPIKey, "--base-url", server.URL,
+		"--target", "email=ada@example.com", "--output", "json")
+	var invitations []vortex.InvitationResult
+	if err := json.Unmarshal([]byte(stdout), &invitations); err != nil || code != ExitOK {
+		t.Fatalf("Expected a JSON list, got %d %q", code, stdout)
+	}
+	if len(invitations) != 1 || invitations[0].ID != "inv-1" {
+		t.Errorf("Expected inv-1, got %+v", invitations)
+	}
+}
+
+func TestInvitationsRevoke(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasSuffix(r.URL.Path, "/missing") {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	code, stdout, stderr := runCLI("invitations", "revoke", "--api-key", testAPIKey, "--base-url", server.URL, "inv-1", "missing")
+	if code != ExitError {
+		t.Errorf("Expected exit code 1, got %d", code)
+	}
+	if !strings.Contains(stdout, "revoked inv-1") {
+		t.Errorf("Expected inv-1 to be revoked, got %q", stdout)
+	}
+	if !strings.Contains(stderr, "failed to revoke missing") {
+		t.Errorf("Expected missing to fail, got %q", stderr)
+	}
+}
+
+func TestJWTGenerate(t *testing.T) {
+	code, stdout, stderr := runCLI("jwt", "generate", "--api-key", testAPIKey, "--user-id", "user-123", "--email", "ada@example.com")
+	if code != ExitOK {
+		t.Fatalf("Expected exit code 0, got %d: %s", code, stderr)
+	}
+
+	claims, err := vortex.NewClient(testAPIKey).VerifyJWT(strings.TrimSpace(stdout))
+	if err != nil {
+		t.Fatalf("Expected a valid token, got %v", err)
+	}
+	if claims.UserID != "user-123" || claims.UserEmail != "ada@example.com" {
+		t.Errorf("Unexpected claims %+v", claims)
+	}
+}
+
+func TestUsageErrors(t *testing.T) {
+	tests := [][]string{
+		{},
+		{"invitations", "frobnicate"},
+		{"jwt", "generate", "--api-key", testAPIKey},
+		{"jwt", "generate", "--api-key", testAPIKey, "--user-id", "u", "--output", "yaml"},
+		{"invitations", "get", "--api-key", testAPIKey},
+		{"invitations", "list", "--api-key", testAPIKey, "--target", "emial=ada@example.com"},
+		{"invitations", "list", "--api-key", testAPIKey, "--target", "email=ada@example.com", "--group", "team=team-1"},
+	}
+	for _, args := range tests {
+		if code, _, _ := runCLI(args...); code != ExitUsage {
+			t.Errorf("%v: Expected exit code 2, got %d", args, code)
+		}
+	}
+}
+
+func TestJSONErrors(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	tests := []struct {
+		args []string
+		code int
+	}{
+		{[]string{"invitations", "get", "--api-key", testAPIKey, "--base-url", server.URL, "--output", "json", "missing"}, ExitError},
+		{[]string{"invitations", "list", "--api-key", testAPIKey, "--output", "json", "--target", "email=ada@example.com", "--group", "team=team-1"}, ExitUsage},
+		{[]string{"invitations", "frobnicate", "--output=json"}, ExitUsage},
+	}
+	for _, tt := range tests {
+		code, stdout, _ := runCLI(tt.args...)
+		if code != tt.code {
+			t.Errorf("%v: Expected exit code %d, got %d", tt.args, tt.code, code)
+		}
+
+		var output struct {
+			Error    string `json:"error"`
+			ExitCode int    `json:"exitCode"`
+		}
+		if err := json.Unmarshal([]byte(stdout), &output); err != nil {
+			t.Errorf("%v: Expected a JSON error, got %q", tt.args, stdout)
+			continue
+		}
+		if output.Error == "" || output.ExitCode != tt.code {
+			t.Errorf("%v: Expected an error with exit code %d, got %+v", tt.args, tt.code, output)
+		}
+	}
+}
@@ -0,0 +1,58 @@
+package vortex
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ErrConflict matches updates rejected because the resource changed since it
+// was read
+var ErrConflict = errors.New("vortex: resource was modified concurrently")
+
+// ConflictError is the cause of a 412 APIError, holding the current state of
+// the resource so the caller can merge and retry
+type ConflictError struct {
+	// Current is the resource as the server has it now
+	Current json.RawMessage
+}
+
+func (e *ConflictError) Error() string {
+	return ErrConflict.Error()
+}
+
+// Is makes errors.Is(err, ErrConflict) match
+func (e *ConflictError) Is(target error) bool {
+	return target == ErrConflict
+}
+
+// Decode unmarshals the current state of the resource into v
+func (e *ConflictError) Decode(v interface{}) error {
+	if err := json.Unmarshal(e.Current, v); err != nil {
+		return fmt.Errorf("failed to unmarshal current state: %w", err)
+	}
+	return nil
+}
+
+// IfMatch makes an update apply only if the resource is still at version, as
+// returned in its Version field; otherwise the call fails with an error
+// matching ErrConflict
+//
+// Example:
+//
+//	_, err := client.PatchInvitationAttributes(ctx, invitation.ID, patch, vortex.IfMatch(invitation.Version))
+//	var conflict *vortex.ConflictError
+//	if errors.As(err, &conflict) {
+//	    var current vortex.InvitationResult
+//	    conflict.Decode(&current)
+//	    // merge with current and retry
+//	}
+func IfMatch(version string) RequestOption {
+	return func(o *requestOptions) {
+		if !strings.HasPrefix(version, `"`) && !strings.HasPrefix(version, `W/"`) {
+			version = `"` + version + `"`
+		}
+		o.header.Set("If-Match", version)
+	}
+}
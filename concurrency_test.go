@@ -0,0 +1,72 @@
+package vortex
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestIfMatchConflict(t *testing.T) {
+	current := InvitationResult{ID: "inv-123", Version: "v2"}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if got := r.Header.Get("If-Match"); got != `"`+current.Version+`"` {
+			w.WriteHeader(http.StatusPreconditionFailed)
+			json.NewEncoder(w).Encode(current)
+			return
+		}
+		current.Version = "v3"
+		json.NewEncoder(w).Encode(current)
+	}))
+	defer server.Close()
+
+	client := NewClientWithOptions("test-api-key", server.URL, nil)
+	ctx := context.Background()
+	attrs := map[string]interface{}{"attributes": map[string]interface{}{"plan": "pro"}}
+
+	_, err := client.PatchInvitationAttributes(ctx, "inv-123", attrs, IfMatch("v1"))
+	if !errors.Is(err, ErrConflict) {
+		t.Fatalf("Expected ErrConflict, got %v", err)
+	}
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusPreconditionFailed {
+		t.Errorf("Expected 412 APIError, got %v", err)
+	}
+
+	var conflict *ConflictError
+	if !errors.As(err, &conflict) {
+		t.Fatalf("Expected ConflictError, got %T", err)
+	}
+	var latest InvitationResult
+	if err := conflict.Decode(&latest); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if latest.Version != "v2" {
+		t.Errorf("Expected current version v2, got %s", latest.Version)
+	}
+
+	result, err := client.PatchInvitationAttributes(ctx, "inv-123", attrs, IfMatch(latest.Version))
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if result.Version != "v3" {
+		t.Errorf("Expected version v3, got %s", result.Version)
+	}
+}
+
+func TestIfMatchQuoting(t *testing.T) {
+	for version, want := range map[string]string{
+		"v1":     `"v1"`,
+		`"v1"`:   `"v1"`,
+		`W/"v1"`: `W/"v1"`,
+	} {
+		req := httptest.NewRequest("PUT", "/", nil)
+		applyRequestOptions(req, []RequestOption{IfMatch(version)})
+		if got := req.Header.Get("If-Match"); got != want {
+			t.Errorf("Expected If-Match %s for %s, got %s", want, version, got)
+		}
+	}
+}
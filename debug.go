@@ -0,0 +1,152 @@
+package vortex
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// debugBodyLimit is how many bytes of each body WithDebug writes
+const debugBodyLimit = 4096
+
+// redactedHeaders are written by WithDebug with their values hidden
+var redactedHeaders = map[string]bool{
+	"X-Api-Key":     true,
+	"Authorization": true,
+	"Cookie":        true,
+	"Set-Cookie":    true,
+}
+
+var secretPatterns = []*regexp.Regexp{
+	// API keys
+	regexp.MustCompile(`VRTX\.[A-Za-z0-9_-]+\.[A-Za-z0-9_-]+`),
+	// JWTs
+	regexp.MustCompile(`eyJ[A-Za-z0-9_-]*\.[A-Za-z0-9_-]+\.[A-Za-z0-9_-]+`),
+}
+
+// WithDebug writes every request and response to w: the method, URL,
+// headers, status, latency and the first 4 KiB of each body
+//
+// API keys, JWTs, Authorization headers and cookies are redacted, but other
+// personal data such as invitee email addresses is written as is, so do not
+// leave it on in production.
+//
+// Example:
+//
+//	client := vortex.NewClient(apiKey, vortex.WithDebug(os.Stderr))
+func WithDebug(w io.Writer) ClientOption {
+	return func(c *Client) {
+		if w == nil {
+			c.debug = nil
+			return
+		}
+		c.debug = &debugWriter{w: w}
+	}
+}
+
+// debugWriter serializes dumps from concurrent requests
+type debugWriter struct {
+	mu sync.Mutex
+	w  io.Writer
+}
+
+func (d *debugWriter) write(dump *bytes.Buffer) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.w.Write(dump.Bytes())
+}
+
+// wrap is a Middleware dumping each request and response
+func (d *debugWriter) wrap(next RoundTripFunc) RoundTripFunc {
+	return func(req *http.Request) (*http.Response, error) {
+		return d.roundTrip(req, next)
+	}
+}
+
+func (d *debugWriter) roundTrip(req *http.Request, next RoundTripFunc) (*http.Response, error) {
+	var dump bytes.Buffer
+	fmt.Fprintf(&dump, "--> %s %s\n", req.Method, redact(req.URL.String()))
+	writeHeaders(&dump, req.Header)
+	var head []byte
+	if req.GetBody != nil {
+		if body, err := req.GetBody(); err == nil {
+			head, _ = io.ReadAll(io.LimitReader(body, debugBodyLimit+1))
+			body.Close()
+		}
+	}
+	writeBody(&dump, head, req.ContentLength)
+	d.write(&dump)
+
+	start := time.Now()
+	resp, err := next(req)
+	latency := time.Since(start).Round(time.Millisecond)
+
+	dump.Reset()
+	if err != nil {
+		fmt.Fprintf(&dump, "<-- %s %s failed after %s: %s\n\n", req.Method, redact(req.URL.String()), latency, redact(err.Error()))
+		d.write(&dump)
+		return resp, err
+	}
+
+	fmt.Fprintf(&dump, "<-- %s %s %s (%s)\n", resp.Status, req.Method, redact(req.URL.String()), latency)
+	writeHeaders(&dump, resp.Header)
+	head, _ = io.ReadAll(io.LimitReader(resp.Body, debugBodyLimit+1))
+	writeBody(&dump, head, resp.ContentLength)
+	d.write(&dump)
+
+	// Put the bytes read back in front of the rest of the body
+	resp.Body = struct {
+		io.Reader
+		io.Closer
+	}{io.MultiReader(bytes.NewReader(head), resp.Body), resp.Body}
+	return resp, nil
+}
+
+func writeHeaders(dump *bytes.Buffer, header http.Header) {
+	names := make([]string, 0, len(header))
+	for name := range header {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		value := strings.Join(header[name], ", ")
+		if redactedHeaders[http.CanonicalHeaderKey(name)] {
+			value = "[REDACTED]"
+		}
+		fmt.Fprintf(dump, "%s: %s\n", name, redact(value))
+	}
+}
+
+// writeBody writes head, the start of a body of the given length, which is
+// -1 if unknown
+func writeBody(dump *bytes.Buffer, head []byte, length int64) {
+	truncated := len(head) > debugBodyLimit
+	if truncated {
+		head = head[:debugBodyLimit]
+	}
+	if len(head) > 0 {
+		dump.WriteString("\n")
+		dump.WriteString(redact(string(head)))
+		dump.WriteString("\n")
+	}
+	if truncated && length > debugBodyLimit {
+		fmt.Fprintf(dump, "... (%d more bytes)\n", length-debugBodyLimit)
+	} else if truncated {
+		dump.WriteString("... (truncated)\n")
+	}
+	dump.WriteString("\n")
+}
+
+// redact hides API keys and JWTs in s
+func redact(s string) string {
+	for _, pattern := range secretPatterns {
+		s = pattern.ReplaceAllString(s, "[REDACTED]")
+	}
+	return s
+}
@@ -0,0 +1,180 @@
+package vortex
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// SchemaDriftError reports fields in an API response that the SDK's types do
+// not know about, which would otherwise be dropped silently
+type SchemaDriftError struct {
+	// Fields are the paths of the unexpected fields, e.g.
+	// "invitations[0].reminderPolicy"
+	Fields []string
+	// Fragment is a JSON object mapping each path to its raw value
+	Fragment json.RawMessage
+}
+
+func (e *SchemaDriftError) Error() string {
+	return fmt.Sprintf("response has unexpected fields: %s", strings.Join(e.Fields, ", "))
+}
+
+// WithStrictDecoding makes API calls fail with a *SchemaDriftError when a
+// response contains fields the SDK does not know about
+//
+// Use it in tests and staging to learn about API changes before they cause
+// silent data loss.
+func WithStrictDecoding() ClientOption {
+	return func(c *Client) {
+		c.strictDecoding = true
+	}
+}
+
+// OnSchemaDrift calls hook when a response contains fields the SDK does not
+// know about, e.g. to raise an alert
+//
+// Without WithStrictDecoding the call still succeeds and the unknown fields
+// are dropped.
+func OnSchemaDrift(hook func(err *SchemaDriftError)) ClientOption {
+	return func(c *Client) {
+		c.onSchemaDrift = hook
+	}
+}
+
+// decode unmarshals a response body into v, checking it for unknown fields
+// when strict decoding or a drift hook is configured
+func (c *Client) decode(data []byte, v interface{}) error {
+	if err := unmarshal(data, v); err != nil {
+		return err
+	}
+	if !c.strictDecoding && c.onSchemaDrift == nil {
+		return nil
+	}
+
+	driftErr := schemaDrift(data, v)
+	if driftErr == nil {
+		return nil
+	}
+	if c.onSchemaDrift != nil {
+		c.onSchemaDrift(driftErr)
+	}
+	if c.strictDecoding {
+		return driftErr
+	}
+	return nil
+}
+
+// schemaDrift compares data against the type of v
+func schemaDrift(data []byte, v interface{}) *SchemaDriftError {
+	var raw interface{}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return nil
+	}
+
+	unknown := make(map[string]interface{})
+	collectUnknownFields(reflect.TypeOf(v), raw, "", unknown)
+	if len(unknown) == 0 {
+		return nil
+	}
+
+	fields := make([]string, 0, len(unknown))
+	for path := range unknown {
+		fields = append(fields, path)
+	}
+	sort.Strings(fields)
+
+	fragment, _ := json.Marshal(unknown)
+	return &SchemaDriftError{Fields: fields, Fragment: fragment}
+}
+
+var jsonUnmarshalerType = reflect.TypeOf((*json.Unmarshaler)(nil)).Elem()
+
+func collectUnknownFields(t reflect.Type, raw interface{}, path string, unknown map[string]interface{}) {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	// Types with their own decoding decide for themselves what they accept
+	if reflect.PtrTo(t).Implements(jsonUnmarshalerType) {
+		return
+	}
+
+	switch t.Kind() {
+	case reflect.Struct:
+		object, ok := raw.(map[string]interface{})
+		if !ok {
+			return
+		}
+		fields := jsonFields(t)
+		for key, value := range object {
+			field, ok := fields[strings.ToLower(key)]
+			if !ok {
+				unknown[joinPath(path, key)] = value
+				continue
+			}
+			collectUnknownFields(field, value, joinPath(path, key), unknown)
+		}
+	case reflect.Slice, reflect.Array:
+		items, ok := raw.([]interface{})
+		if !ok {
+			return
+		}
+		for i, item := range items {
+			collectUnknownFields(t.Elem(), item, fmt.Sprintf("%s[%d]", path, i), unknown)
+		}
+	case reflect.Map:
+		object, ok := raw.(map[string]interface{})
+		if !ok {
+			return
+		}
+		for key, value := range object {
+			collectUnknownFields(t.Elem(), value, joinPath(path, key), unknown)
+		}
+	}
+}
+
+// jsonFields maps the lowercased JSON names of a struct's fields to their
+// types, matching encoding/json's case-insensitive field lookup
+func jsonFields(t reflect.Type) map[string]reflect.Type {
+	fields := make(map[string]reflect.Type)
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		tag := field.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		name := strings.Split(tag, ",")[0]
+
+		if field.Anonymous && name == "" {
+			embedded := field.Type
+			if embedded.Kind() == reflect.Ptr {
+				embedded = embedded.Elem()
+			}
+			if embedded.Kind() == reflect.Struct {
+				for key, fieldType := range jsonFields(embedded) {
+					if _, ok := fields[key]; !ok {
+						fields[key] = fieldType
+					}
+				}
+				continue
+			}
+		}
+		if field.PkgPath != "" {
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+		fields[strings.ToLower(name)] = field.Type
+	}
+	return fields
+}
+
+func joinPath(path, key string) string {
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
@@ -0,0 +1,81 @@
+package vortex
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+const driftResponse = `{
+	"id": "inv1",
+	"status": "pending",
+	"reminderPolicy": "weekly",
+	"groups": [{"id": "g1", "groupId": "ws-123", "region": "eu"}],
+	"effectivePolicy": {"allowedDomains": ["example.com"], "blockedReason": ""},
+	"Attributes": {"anything": {"goes": true}}
+}`
+
+func TestStrictDecoding(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(driftResponse))
+	}))
+	defer server.Close()
+
+	client := NewClientWithOptions("test-api-key", server.URL, nil, WithStrictDecoding())
+
+	_, err := client.GetInvitation("inv1")
+
+	var driftErr *SchemaDriftError
+	if !errors.As(err, &driftErr) {
+		t.Fatalf("Expected a SchemaDriftError, got %v", err)
+	}
+	if len(driftErr.Fields) != 2 || driftErr.Fields[0] != "groups[0].region" || driftErr.Fields[1] != "reminderPolicy" {
+		t.Errorf("Expected fields [groups[0].region reminderPolicy], got %v", driftErr.Fields)
+	}
+
+	var fragment map[string]interface{}
+	if err := json.Unmarshal(driftErr.Fragment, &fragment); err != nil {
+		t.Fatalf("Expected a JSON fragment, got %v", err)
+	}
+	if fragment["reminderPolicy"] != "weekly" {
+		t.Errorf("Expected the raw value of reminderPolicy, got %v", fragment["reminderPolicy"])
+	}
+}
+
+func TestOnSchemaDrift(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(driftResponse))
+	}))
+	defer server.Close()
+
+	var reported *SchemaDriftError
+	client := NewClientWithOptions("test-api-key", server.URL, nil, OnSchemaDrift(func(err *SchemaDriftError) {
+		reported = err
+	}))
+
+	invitation, err := client.GetInvitation("inv1")
+	if err != nil {
+		t.Fatalf("Expected drift not to fail the call, got %v", err)
+	}
+	if invitation.Status != "pending" {
+		t.Errorf("Expected status 'pending', got %s", invitation.Status)
+	}
+	if reported == nil || len(reported.Fields) != 2 {
+		t.Errorf("Expected the hook to report 2 fields, got %+v", reported)
+	}
+}
+
+func TestStrictDecoding_KnownFields(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"invitations": [{"id": "inv1", "target": [{"type": "email", "value": "a@example.com"}]}]}`))
+	}))
+	defer server.Close()
+
+	client := NewClientWithOptions("test-api-key", server.URL, nil, WithStrictDecoding())
+
+	if _, err := client.GetInvitationsByGroup("workspace", "ws-123"); err != nil {
+		t.Errorf("Expected no error for known fields, got %v", err)
+	}
+}
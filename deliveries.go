@@ -0,0 +1,87 @@
+package vortex
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// Delivery statuses
+const (
+	DeliveryStatusSent    = "sent"
+	DeliveryStatusBounced = "bounced"
+	DeliveryStatusOpened  = "opened"
+	DeliveryStatusClicked = "clicked"
+	DeliveryStatusFailed  = "failed"
+)
+
+// Delivery is one attempt to deliver an invitation over a channel
+type Delivery struct {
+	ID      string           `json:"id"`
+	Channel DeliveryType     `json:"channel"`
+	Target  InvitationTarget `json:"target"`
+	// Status is the most recent DeliveryStatus* value
+	Status string `json:"status"`
+	// FailureReason explains a bounced or failed delivery, e.g. "mailbox
+	// does not exist"
+	FailureReason string     `json:"failureReason,omitempty"`
+	SentAt        *time.Time `json:"sentAt,omitempty"`
+	UpdatedAt     time.Time  `json:"updatedAt"`
+}
+
+// Failed reports whether the delivery bounced or failed
+func (d *Delivery) Failed() bool {
+	return d.Status == DeliveryStatusBounced || d.Status == DeliveryStatusFailed
+}
+
+// DeliveriesResponse represents the API response for delivery lists
+type DeliveriesResponse struct {
+	Deliveries []Delivery `json:"deliveries"`
+}
+
+// GetInvitationDeliveries retrieves the delivery attempts for an invitation
+//
+// Example:
+//
+//	deliveries, err := client.GetInvitationDeliveries(ctx, "invitation-id")
+//	for _, d := range deliveries {
+//	    if d.Failed() {
+//	        fmt.Printf("%s to %s failed: %s\n", d.Channel, d.Target.Value, d.FailureReason)
+//	    }
+//	}
+func (c *Client) GetInvitationDeliveries(ctx context.Context, invitationID string, opts ...RequestOption) ([]Delivery, error) {
+	path := fmt.Sprintf("/api/v1/invitations/%s/deliveries", invitationID)
+
+	responseBody, err := c.apiRequestContext(ctx, "GET", path, nil, nil, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	var response DeliveriesResponse
+	if err := c.decode(responseBody, &response); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	return response.Deliveries, nil
+}
+
+// RetryDelivery re-attempts a single failed delivery, e.g. after fixing a DNS
+// or SPF problem
+//
+// Unlike Reinvite it sends the same message over the same channel to the same
+// target, without starting a new delivery round.
+func (c *Client) RetryDelivery(ctx context.Context, invitationID, deliveryID string, opts ...RequestOption) (*Delivery, error) {
+	path := fmt.Sprintf("/api/v1/invitations/%s/deliveries/%s/retry", invitationID, deliveryID)
+
+	responseBody, err := c.apiRequestContext(ctx, "POST", path, nil, nil, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	var delivery Delivery
+	if err := c.decode(responseBody, &delivery); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	return &delivery, nil
+}
@@ -0,0 +1,57 @@
+package vortex
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestGetInvitationDeliveries(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "GET" || r.URL.Path != "/api/v1/invitations/inv1/deliveries" {
+			t.Errorf("Expected GET /api/v1/invitations/inv1/deliveries, got %s %s", r.Method, r.URL.Path)
+		}
+		w.Write([]byte(`{"deliveries": [
+			{"id": "d1", "channel": "email", "target": {"type": "email", "value": "a@example.com"}, "status": "opened"},
+			{"id": "d2", "channel": "email", "target": {"type": "email", "value": "b@example.com"}, "status": "bounced", "failureReason": "mailbox does not exist"}
+		]}`))
+	}))
+	defer server.Close()
+
+	client := NewClientWithOptions("test-api-key", server.URL, nil)
+
+	deliveries, err := client.GetInvitationDeliveries(context.Background(), "inv1")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if len(deliveries) != 2 {
+		t.Fatalf("Expected 2 deliveries, got %d", len(deliveries))
+	}
+	if deliveries[0].Failed() {
+		t.Error("Expected opened delivery not to be failed")
+	}
+	if !deliveries[1].Failed() || deliveries[1].FailureReason != "mailbox does not exist" {
+		t.Errorf("Expected bounced delivery with reason, got %+v", deliveries[1])
+	}
+}
+
+func TestRetryDelivery(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "POST" || r.URL.Path != "/api/v1/invitations/inv1/deliveries/d2/retry" {
+			t.Errorf("Expected POST /api/v1/invitations/inv1/deliveries/d2/retry, got %s %s", r.Method, r.URL.Path)
+		}
+		w.Write([]byte(`{"id": "d2", "channel": "email", "status": "sent"}`))
+	}))
+	defer server.Close()
+
+	client := NewClientWithOptions("test-api-key", server.URL, nil)
+
+	delivery, err := client.RetryDelivery(context.Background(), "inv1", "d2")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if delivery.Status != DeliveryStatusSent {
+		t.Errorf("Expected status 'sent', got %s", delivery.Status)
+	}
+}
@@ -0,0 +1,83 @@
+package vortex
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// DeprecationNotice describes an endpoint the API reported as deprecated
+// through its Deprecation and Sunset response headers
+type DeprecationNotice struct {
+	Method string
+	Path   string
+	// DeprecatedAt is when the endpoint was deprecated, zero if not given
+	DeprecatedAt time.Time
+	// Sunset is when the endpoint will stop working, zero if not given
+	Sunset time.Time
+}
+
+// OnDeprecation calls hook for every response from a deprecated endpoint
+//
+// Independently of the hook, a configured Logger gets one warning per method
+// and path.
+//
+// Example:
+//
+//	client := vortex.NewClient(apiKey, vortex.OnDeprecation(func(ctx context.Context, n vortex.DeprecationNotice) {
+//	    metrics.Incr("vortex.deprecated_call", "path:"+n.Path)
+//	}))
+func OnDeprecation(hook func(ctx context.Context, notice DeprecationNotice)) ClientOption {
+	return func(c *Client) {
+		c.onDeprecation = hook
+	}
+}
+
+// deprecationWarnings records the endpoints already logged as deprecated
+type deprecationWarnings struct {
+	logged sync.Map
+}
+
+// checkDeprecation reports a response from a deprecated endpoint
+func (c *Client) checkDeprecation(ctx context.Context, method, path string, header http.Header) {
+	deprecation := header.Get("Deprecation")
+	sunset := header.Get("Sunset")
+	if deprecation == "" && sunset == "" {
+		return
+	}
+
+	notice := DeprecationNotice{
+		Method:       method,
+		Path:         path,
+		DeprecatedAt: parseDeprecationDate(deprecation),
+	}
+	if t, err := http.ParseTime(sunset); err == nil {
+		notice.Sunset = t
+	}
+
+	if c.onDeprecation != nil {
+		c.onDeprecation(ctx, notice)
+	}
+	if c.logger != nil && c.deprecations != nil {
+		if _, logged := c.deprecations.logged.LoadOrStore(method+" "+path, true); !logged {
+			c.logger.Warn("vortex endpoint is deprecated", "method", method, "path", path, "sunset", sunset)
+		}
+	}
+}
+
+// parseDeprecationDate parses a Deprecation header, which is either a
+// structured date ("@1688169599") or, in older drafts, an HTTP date or "true"
+func parseDeprecationDate(value string) time.Time {
+	if strings.HasPrefix(value, "@") {
+		if seconds, err := strconv.ParseInt(value[1:], 10, 64); err == nil {
+			return time.Unix(seconds, 0).UTC()
+		}
+	}
+	if t, err := http.ParseTime(value); err == nil {
+		return t
+	}
+	return time.Time{}
+}
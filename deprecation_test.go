@@ -0,0 +1,75 @@
+package vortex
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestOnDeprecation(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Deprecation", "@1688169599")
+		w.Header().Set("Sunset", "Wed, 01 Jan 2025 00:00:00 GMT")
+		w.Write([]byte(`{"id": "inv1"}`))
+	}))
+	defer server.Close()
+
+	var notices []DeprecationNotice
+	logger := &recordingLogger{}
+	client := NewClientWithOptions("test-api-key", server.URL, nil,
+		WithLogger(logger),
+		OnDeprecation(func(ctx context.Context, notice DeprecationNotice) {
+			notices = append(notices, notice)
+		}))
+
+	for i := 0; i < 3; i++ {
+		if _, err := client.GetInvitation("inv1"); err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+	}
+
+	if len(notices) != 3 {
+		t.Fatalf("Expected the hook to run for every response, got %d", len(notices))
+	}
+	notice := notices[0]
+	if notice.Method != "GET" || notice.Path != "/api/v1/invitations/inv1" {
+		t.Errorf("Expected GET /api/v1/invitations/inv1, got %s %s", notice.Method, notice.Path)
+	}
+	if !notice.DeprecatedAt.Equal(time.Unix(1688169599, 0)) {
+		t.Errorf("Expected deprecation date from the structured header, got %v", notice.DeprecatedAt)
+	}
+	if !notice.Sunset.Equal(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("Expected sunset 2025-01-01, got %v", notice.Sunset)
+	}
+	warnings := 0
+	for _, line := range logger.lines {
+		if strings.HasPrefix(line, "warn ") {
+			warnings++
+		}
+	}
+	if warnings != 1 {
+		t.Errorf("Expected one warning per endpoint, got %v", logger.lines)
+	}
+}
+
+func TestOnDeprecation_NotDeprecated(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"id": "inv1"}`))
+	}))
+	defer server.Close()
+
+	called := false
+	client := NewClientWithOptions("test-api-key", server.URL, nil, OnDeprecation(func(ctx context.Context, notice DeprecationNotice) {
+		called = true
+	}))
+
+	if _, err := client.GetInvitation("inv1"); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if called {
+		t.Error("Expected no notice without deprecation headers")
+	}
+}
@@ -0,0 +1,77 @@
+package vortex
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// Request is a raw API request for Do
+type Request struct {
+	Method string
+	// Path is relative to the base URL, e.g. "/api/v1/invitations/inv-1"
+	Path  string
+	Query map[string]string
+	// Body is encoded as JSON; pass a json.RawMessage to send it as is
+	Body interface{}
+}
+
+// Response is a raw API response returned by Do
+type Response struct {
+	StatusCode int
+	Header     http.Header
+	Body       []byte
+}
+
+// Decode unmarshals the response body into v
+func (r *Response) Decode(v interface{}) error {
+	if err := unmarshal(r.Body, v); err != nil {
+		return fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+	return nil
+}
+
+// Do sends a raw request to any API endpoint, for calling new or beta
+// endpoints before the SDK has typed methods for them
+//
+// The request is authenticated, rate limited, logged and reported like any
+// other; error responses are returned as *APIError. Responses are never cached,
+// and mutating requests do not invalidate the cache.
+//
+// Example:
+//
+//	resp, err := client.Do(ctx, vortex.Request{
+//	    Method: "PATCH",
+//	    Path:   "/api/v1/invitations/inv-1/beta-settings",
+//	    Body:   map[string]interface{}{"enabled": true},
+//	})
+//	if err != nil {
+//	    return err
+//	}
+//	var settings BetaSettings
+//	err = resp.Decode(&settings)
+func (c *Client) Do(ctx context.Context, req Request) (*Response, error) {
+	return c.send(ctx, req.Method, req.Path, req.Body, req.Query, nil)
+}
+
+// DoJSON is like Do for the common case of a JSON endpoint: it sends body,
+// applies opts like any other call and unmarshals the response into out
+//
+// out may be nil to discard the response, and path may carry a query string.
+// Error responses are returned as *APIError.
+//
+// Example:
+//
+//	var settings BetaSettings
+//	err := client.DoJSON(ctx, "PATCH", "/api/v1/invitations/inv-1/beta-settings",
+//	    map[string]interface{}{"enabled": true}, &settings, vortex.IdempotencyKey(key))
+func (c *Client) DoJSON(ctx context.Context, method, path string, body, out interface{}, opts ...RequestOption) error {
+	resp, err := c.send(ctx, method, path, body, nil, opts)
+	if err != nil {
+		return err
+	}
+	if out == nil || len(resp.Body) == 0 {
+		return nil
+	}
+	return resp.Decode(out)
+}
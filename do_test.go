@@ -0,0 +1,113 @@
+package vortex
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestDo(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "PATCH" || r.URL.Path != "/api/v1/beta/thing" {
+			t.Errorf("Expected PATCH /api/v1/beta/thing, got %s %s", r.Method, r.URL.Path)
+		}
+		if r.URL.Query().Get("dryRun") != "true" {
+			t.Errorf("Expected dryRun query parameter, got %s", r.URL.RawQuery)
+		}
+		if r.Header.Get("x-api-key") != "test-api-key" {
+			t.Error("Expected the API key to be sent")
+		}
+
+		var req map[string]interface{}
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Errorf("Failed to decode request body: %v", err)
+		}
+		if req["enabled"] != true {
+			t.Errorf("Expected enabled true, got %v", req)
+		}
+
+		w.Header().Set("X-Beta", "1")
+		w.WriteHeader(http.StatusAccepted)
+		w.Write([]byte(`{"enabled": true}`))
+	}))
+	defer server.Close()
+
+	client := NewClientWithOptions("test-api-key", server.URL, nil)
+
+	resp, err := client.Do(context.Background(), Request{
+		Method: "PATCH",
+		Path:   "/api/v1/beta/thing",
+		Query:  map[string]string{"dryRun": "true"},
+		Body:   json.RawMessage(`{"enabled": true}`),
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if resp.StatusCode != http.StatusAccepted || resp.Header.Get("X-Beta") != "1" {
+		t.Errorf("Expected status 202 with headers, got %d %v", resp.StatusCode, resp.Header)
+	}
+
+	var out struct {
+		Enabled bool `json:"enabled"`
+	}
+	if err := resp.Decode(&out); err != nil || !out.Enabled {
+		t.Errorf("Expected decoded body, got %+v, %v", out, err)
+	}
+}
+
+func TestDo_Error(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	client := NewClientWithOptions("test-api-key", server.URL, nil)
+
+	_, err := client.Do(context.Background(), Request{Method: "GET", Path: "/api/v1/beta/missing"})
+
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != 404 {
+		t.Errorf("Expected a 404 APIError, got %v", err)
+	}
+}
+
+func TestDoJSON(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v1/beta/thing" {
+			t.Errorf("Expected /api/v1/beta/thing, got %s", r.URL.Path)
+		}
+		if r.URL.Query().Get("dryRun") != "true" {
+			t.Errorf("Expected dryRun query parameter, got %s", r.URL.RawQuery)
+		}
+		if r.Header.Get("Idempotency-Key") != "job-1" {
+			t.Errorf("Expected the request options to apply, got %v", r.Header)
+		}
+		if r.Method == "DELETE" {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		w.Write([]byte(`{"enabled": true}`))
+	}))
+	defer server.Close()
+
+	client := NewClientWithOptions("test-api-key", server.URL, nil)
+
+	var out struct {
+		Enabled bool `json:"enabled"`
+	}
+	err := client.DoJSON(context.Background(), "POST", "/api/v1/beta/thing?dryRun=true",
+		map[string]interface{}{"enabled": true}, &out, IdempotencyKey("job-1"))
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if !out.Enabled {
+		t.Errorf("Expected decoded body, got %+v", out)
+	}
+
+	if err := client.DoJSON(context.Background(), "DELETE", "/api/v1/beta/thing?dryRun=true", nil, nil, IdempotencyKey("job-1")); err != nil {
+		t.Errorf("Expected no error with a nil out, got %v", err)
+	}
+}
@@ -0,0 +1,136 @@
+package vortex
+
+import (
+	"errors"
+	"fmt"
+)
+
+// InvitationStatus is the status of an invitation
+type InvitationStatus string
+
+// Invitation statuses
+const (
+	// StatusQueued is created but not yet sent
+	StatusQueued InvitationStatus = "queued"
+	// StatusSending is being delivered
+	StatusSending InvitationStatus = "sending"
+	// StatusDelivered reached the target's inbox or phone
+	StatusDelivered InvitationStatus = "delivered"
+	// StatusPending is waiting for the target to respond
+	StatusPending InvitationStatus = "pending"
+	// StatusAccepted was accepted by the target
+	StatusAccepted InvitationStatus = "accepted"
+	// StatusRevoked was revoked and can no longer be accepted
+	StatusRevoked InvitationStatus = "revoked"
+	// StatusExpired passed its expiry before it was accepted
+	StatusExpired InvitationStatus = "expired"
+	// StatusPendingApproval is held until an admin approves or rejects it
+	StatusPendingApproval InvitationStatus = "pending_approval"
+	// StatusRejected was rejected by an admin and will not be sent
+	StatusRejected InvitationStatus = "rejected"
+)
+
+// Valid reports whether s is one of the Status constants
+//
+// The API may introduce new statuses, so treat an invalid status in a
+// response as unknown rather than as an error.
+func (s InvitationStatus) Valid() bool {
+	switch s {
+	case StatusQueued, StatusSending, StatusDelivered, StatusPending, StatusAccepted,
+		StatusRevoked, StatusExpired, StatusPendingApproval, StatusRejected:
+		return true
+	}
+	return false
+}
+
+// HistoryEventType is the kind of an InvitationHistoryEvent
+type HistoryEventType string
+
+// Invitation history event types
+const (
+	// HistoryCreated is the invitation being created
+	HistoryCreated HistoryEventType = "created"
+	// HistorySent is a delivery of the invitation being sent
+	HistorySent HistoryEventType = "sent"
+	// HistoryViewed is the target opening the invitation
+	HistoryViewed HistoryEventType = "viewed"
+	// HistoryClicked is the target following the invitation's link
+	HistoryClicked HistoryEventType = "clicked"
+	// HistoryAccepted is the target accepting the invitation
+	HistoryAccepted HistoryEventType = "accepted"
+	// HistoryRevoked is the invitation being revoked
+	HistoryRevoked HistoryEventType = "revoked"
+	// HistoryExpired is the invitation passing its expiry
+	HistoryExpired HistoryEventType = "expired"
+)
+
+// Valid reports whether t is one of the History constants
+//
+// The API may record new kinds of events, so treat an invalid type in a
+// response as unknown rather than as an error.
+func (t HistoryEventType) Valid() bool {
+	switch t {
+	case HistoryCreated, HistorySent, HistoryViewed, HistoryClicked, HistoryAccepted,
+		HistoryRevoked, HistoryExpired:
+		return true
+	}
+	return false
+}
+
+// TargetType is the kind of address an invitation is sent to
+type TargetType string
+
+// Target types
+const (
+	TargetEmail       TargetType = "email"
+	TargetSMS         TargetType = "sms"
+	TargetPhoneNumber TargetType = "phoneNumber"
+	TargetUsername    TargetType = "username"
+)
+
+// Valid reports whether t is one of the Target constants
+func (t TargetType) Valid() bool {
+	switch t {
+	case TargetEmail, TargetSMS, TargetPhoneNumber, TargetUsername:
+		return true
+	}
+	return false
+}
+
+// DeliveryType is a channel invitations are delivered on
+type DeliveryType string
+
+// Delivery types
+const (
+	DeliveryEmail DeliveryType = "email"
+	DeliverySMS   DeliveryType = "sms"
+)
+
+// Valid reports whether d is one of the Delivery constants
+func (d DeliveryType) Valid() bool {
+	return d == DeliveryEmail || d == DeliverySMS
+}
+
+// ErrInvalidTargetType is returned for a target type that is not one of the
+// Target constants, e.g. a typo such as "emial"
+var ErrInvalidTargetType = errors.New("vortex: invalid target type")
+
+// ParseTargetType converts s to a TargetType, failing with an error wrapping
+// ErrInvalidTargetType if it is not a known type
+func ParseTargetType(s string) (TargetType, error) {
+	t := TargetType(s)
+	if !t.Valid() {
+		return "", fmt.Errorf("%w %q", ErrInvalidTargetType, s)
+	}
+	return t, nil
+}
+
+// ParseDeliveryType converts s to a DeliveryType, failing if it is not a
+// known channel
+func ParseDeliveryType(s string) (DeliveryType, error) {
+	d := DeliveryType(s)
+	if !d.Valid() {
+		return "", fmt.Errorf("vortex: invalid delivery type %q", s)
+	}
+	return d, nil
+}
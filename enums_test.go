@@ -0,0 +1,89 @@
+package vortex
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestEnumValid(t *testing.T) {
+	if !StatusAccepted.Valid() || InvitationStatus("acepted").Valid() {
+		t.Error("Unexpected InvitationStatus.Valid result")
+	}
+	if !TargetPhoneNumber.Valid() || TargetType("emial").Valid() {
+		t.Error("Unexpected TargetType.Valid result")
+	}
+	if !DeliverySMS.Valid() || DeliveryType("fax").Valid() {
+		t.Error("Unexpected DeliveryType.Valid result")
+	}
+}
+
+func TestParseTargetType(t *testing.T) {
+	targetType, err := ParseTargetType("email")
+	if err != nil || targetType != TargetEmail {
+		t.Errorf("Expected TargetEmail, got %q (err=%v)", targetType, err)
+	}
+
+	if _, err := ParseTargetType("emial"); !errors.Is(err, ErrInvalidTargetType) {
+		t.Errorf("Expected ErrInvalidTargetType, got %v", err)
+	}
+	if _, err := ParseDeliveryType("fax"); err == nil {
+		t.Error("Expected an error for an unknown delivery type")
+	}
+}
+
+func TestGetInvitationsByTargetRejectsUnknownType(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("Expected no request, got %s", r.URL)
+	}))
+	defer server.Close()
+
+	client := NewClientWithOptions("test-api-key", server.URL, nil)
+	if _, err := client.GetInvitationsByTarget("emial", "user@example.com"); !errors.Is(err, ErrInvalidTargetType) {
+		t.Errorf("Expected ErrInvalidTargetType, got %v", err)
+	}
+}
+
+func TestEnumJSON(t *testing.T) {
+	var result InvitationResult
+	data := `{"status":"accepted","deliveryTypes":["email","sms"],"target":[{"type":"phoneNumber","value":"+15551234567"}]}`
+	if err := json.Unmarshal([]byte(data), &result); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if result.Status != StatusAccepted {
+		t.Errorf("Expected StatusAccepted, got %q", result.Status)
+	}
+	if len(result.DeliveryTypes) != 2 || result.DeliveryTypes[1] != DeliverySMS {
+		t.Errorf("Expected [email sms], got %v", result.DeliveryTypes)
+	}
+	if result.Target[0].Type != TargetPhoneNumber {
+		t.Errorf("Expected TargetPhoneNumber, got %q", result.Target[0].Type)
+	}
+}
+
+func TestGetInvitationsForTarget(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("targetType") != "sms" || r.URL.Query().Get("targetValue") != "+15555550100" {
+			t.Errorf("Unexpected query %s", r.URL.RawQuery)
+		}
+		w.Write([]byte(`{"invitations": [{"id": "inv-1"}]}`))
+	}))
+	defer server.Close()
+
+	client := NewClientWithOptions("test-api-key", server.URL, nil)
+
+	invitations, err := client.GetInvitationsForTarget(context.Background(), InvitationTarget{Type: TargetSMS, Value: "+15555550100"})
+	if err != nil || len(invitations) != 1 {
+		t.Fatalf("Expected one invitation, got %v, %v", invitations, err)
+	}
+
+	// The string form still takes untyped values held in variables
+	targetType := "emial"
+	if _, err := client.GetInvitationsByTarget(targetType, "user@example.com"); !errors.Is(err, ErrInvalidTargetType) {
+		t.Errorf("Expected ErrInvalidTargetType, got %v", err)
+	}
+}
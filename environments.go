@@ -0,0 +1,126 @@
+package vortex
+
+import (
+	"context"
+	"errors"
+	"net"
+	"sync"
+	"time"
+)
+
+// Environment is a Vortex API deployment
+type Environment struct {
+	Name    string
+	BaseURL string
+}
+
+// Vortex API deployments
+var (
+	// US is the default deployment
+	US = Environment{Name: "us", BaseURL: defaultBaseURL}
+	// EU keeps data in the European Union
+	EU = Environment{Name: "eu", BaseURL: "https://api.eu.vortexsoftware.com"}
+	// Sandbox is for testing; invitations sent there are never delivered
+	Sandbox = Environment{Name: "sandbox", BaseURL: "https://api.sandbox.vortexsoftware.com"}
+)
+
+// FailbackAfter is how long a client that failed over keeps using the
+// fallback before trying its primary environment again
+const FailbackAfter = time.Minute
+
+// WithEnvironment sends requests to env, overriding VORTEX_API_BASE_URL
+//
+// Example:
+//
+//	client := vortex.NewClient(apiKey, vortex.WithEnvironment(vortex.EU))
+func WithEnvironment(env Environment) ClientOption {
+	return WithBaseURL(env.BaseURL)
+}
+
+// WithFailover sends requests to the fallbacks, in order, when the client's
+// environment cannot be connected to
+//
+// Only requests that could not be sent at all fail over, so mutations are
+// never applied twice. Once a fallback answers, the client keeps using it
+// for FailbackAfter before trying its primary environment again.
+//
+// Example:
+//
+//	client := vortex.NewClient(apiKey,
+//	    vortex.WithEnvironment(vortex.US),
+//	    vortex.WithFailover(vortex.EU),
+//	)
+func WithFailover(fallbacks ...Environment) ClientOption {
+	return func(c *Client) {
+		state := &failoverState{}
+		for _, env := range fallbacks {
+			state.fallbacks = append(state.fallbacks, env.BaseURL)
+		}
+		c.failover = state
+	}
+}
+
+// failoverState tracks which base URL a client and the clients derived from
+// it with AsUser are using
+type failoverState struct {
+	fallbacks []string
+
+	mu sync.Mutex
+	// active indexes the client's base URL followed by the fallbacks
+	active int
+	since  time.Time
+}
+
+// current returns the index of the base URL to try first
+func (f *failoverState) current(now time.Time) int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.active != 0 && now.Sub(f.since) >= FailbackAfter {
+		f.active = 0
+	}
+	return f.active
+}
+
+func (f *failoverState) use(index int, now time.Time) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.active != index {
+		f.active, f.since = index, now
+	}
+}
+
+// withFailover calls attempt with the active base URL, moving on to the next
+// one while the request cannot be sent
+func (c *Client) withFailover(ctx context.Context, attempt func(baseURL string) (*Response, error)) (*Response, error) {
+	if c.failover == nil || len(c.failover.fallbacks) == 0 {
+		return attempt(c.baseURL)
+	}
+
+	baseURLs := append([]string{c.baseURL}, c.failover.fallbacks...)
+	first := c.failover.current(time.Now())
+	var err error
+	for i := range baseURLs {
+		index := (first + i) % len(baseURLs)
+		var resp *Response
+		resp, err = attempt(baseURLs[index])
+		if !connectionFailed(err) {
+			c.failover.use(index, time.Now())
+			return resp, err
+		}
+		if ctx.Err() != nil {
+			return nil, err
+		}
+		if c.logger != nil && i < len(baseURLs)-1 {
+			next := baseURLs[(index+1)%len(baseURLs)]
+			c.logger.Warn("vortex API unreachable, failing over", "from", baseURLs[index], "to", next, "error", err)
+		}
+	}
+	return nil, err
+}
+
+// connectionFailed reports whether err means no connection could be made, so
+// the request was never sent
+func connectionFailed(err error) bool {
+	var opErr *net.OpError
+	return errors.As(err, &opErr) && opErr.Op == "dial"
+}
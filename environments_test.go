@@ -0,0 +1,80 @@
+package vortex
+
+import (
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+// closedURL returns the URL of a port nothing listens on
+func closedURL(t *testing.T) string {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	listener.Close()
+	return "http://" + listener.Addr().String()
+}
+
+func TestWithEnvironment(t *testing.T) {
+	client := NewClient("test-api-key", WithEnvironment(EU))
+	if client.baseURL != EU.BaseURL {
+		t.Errorf("Expected %s, got %s", EU.BaseURL, client.baseURL)
+	}
+}
+
+func TestWithFailover(t *testing.T) {
+	var requests int
+	fallback := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Write([]byte(`{"id": "inv-1"}`))
+	}))
+	defer fallback.Close()
+
+	primary := closedURL(t)
+	client := NewClientWithOptions("test-api-key", primary, nil,
+		WithFailover(Environment{Name: "fallback", BaseURL: fallback.URL}))
+
+	if _, err := client.GetInvitation("inv-1"); err != nil {
+		t.Fatalf("Expected the fallback to answer, got %v", err)
+	}
+	if requests != 1 {
+		t.Errorf("Expected 1 request to the fallback, got %d", requests)
+	}
+	if active := client.failover.current(time.Now()); active != 1 {
+		t.Errorf("Expected the fallback to stay active, got %d", active)
+	}
+	if active := client.failover.current(time.Now().Add(FailbackAfter)); active != 0 {
+		t.Errorf("Expected to fail back to the primary, got %d", active)
+	}
+}
+
+func TestWithFailover_NotOnHTTPErrors(t *testing.T) {
+	primary := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer primary.Close()
+	fallback := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Error("Expected no request to the fallback")
+	}))
+	defer fallback.Close()
+
+	client := NewClientWithOptions("test-api-key", primary.URL, nil,
+		WithFailover(Environment{Name: "fallback", BaseURL: fallback.URL}))
+
+	if _, err := client.GetInvitation("inv-1"); err == nil {
+		t.Error("Expected the primary's error")
+	}
+}
+
+func TestWithFailover_AllUnreachable(t *testing.T) {
+	client := NewClientWithOptions("test-api-key", closedURL(t), nil,
+		WithFailover(Environment{Name: "fallback", BaseURL: closedURL(t)}))
+
+	_, err := client.GetInvitation("inv-1")
+	if !connectionFailed(err) {
+		t.Errorf("Expected a connection error, got %v", err)
+	}
+}
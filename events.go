@@ -0,0 +1,123 @@
+package vortex
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// Event is an event delivered by webhook or StreamEvents
+type Event struct {
+	// ID is unique per event and stays the same when it is redelivered
+	ID        string    `json:"id"`
+	Type      string    `json:"type"`
+	CreatedAt time.Time `json:"createdAt"`
+	// Data is the typed payload: an *InvitationEvent, *InvitationReviewEvent,
+	// *DeliveryBouncedEvent or *DeliveryComplainedEvent, depending on Type.
+	// It is nil for event types this version does not know; RawData is
+	// always set.
+	Data    interface{}     `json:"-"`
+	RawData json.RawMessage `json:"data"`
+}
+
+// ParseEvent decodes an event and its typed payload
+func ParseEvent(payload []byte) (Event, error) {
+	var event Event
+	if err := unmarshal(payload, &event); err != nil {
+		return Event{}, fmt.Errorf("failed to unmarshal event: %w", err)
+	}
+
+	var data interface{}
+	switch event.Type {
+	case EventInvitationCreated, EventInvitationAccepted,
+		EventInvitationRevoked, EventInvitationExpired:
+		data = &InvitationEvent{}
+	case EventInvitationApprovalRequested, EventInvitationApproved,
+		EventInvitationRejected:
+		data = &InvitationReviewEvent{}
+	case EventDeliveryBounced:
+		data = &DeliveryBouncedEvent{}
+	case EventDeliveryComplained:
+		data = &DeliveryComplainedEvent{}
+	default:
+		return event, nil
+	}
+
+	if err := unmarshal(event.RawData, data); err != nil {
+		return Event{}, fmt.Errorf("failed to unmarshal %s event data: %w", event.Type, err)
+	}
+	event.Data = data
+	return event, nil
+}
+
+// Event types, as sent in the "type" field of events
+const (
+	EventInvitationCreated  = "invitation.created"
+	EventInvitationAccepted = "invitation.accepted"
+	EventInvitationRevoked  = "invitation.revoked"
+	EventInvitationExpired  = "invitation.expired"
+
+	EventDeliveryBounced    = "delivery.bounced"
+	EventDeliveryComplained = "delivery.complained"
+
+	EventInvitationApprovalRequested = "invitation.approval_requested"
+	EventInvitationApproved          = "invitation.approved"
+	EventInvitationRejected          = "invitation.rejected"
+)
+
+// Bounce types
+const (
+	// BounceHard is a permanent failure, such as a mailbox that does not exist
+	BounceHard = "hard"
+	// BounceSoft is a temporary failure, such as a full mailbox
+	BounceSoft = "soft"
+)
+
+// InvitationEvent is the payload of the invitation.created,
+// invitation.accepted, invitation.revoked and invitation.expired events
+type InvitationEvent struct {
+	Invitation InvitationResult `json:"invitation"`
+	// Actor is who accepted or revoked the invitation, if known
+	Actor      *Actor    `json:"actor,omitempty"`
+	OccurredAt time.Time `json:"occurredAt"`
+}
+
+// DeliveryBouncedEvent is the payload of a delivery.bounced event
+type DeliveryBouncedEvent struct {
+	InvitationID string           `json:"invitationId"`
+	DeliveryID   string           `json:"deliveryId"`
+	Target       InvitationTarget `json:"target"`
+	// BounceType is BounceHard or BounceSoft
+	BounceType string `json:"bounceType"`
+	// Reason is the receiving server's explanation, e.g. "550 5.1.1 user unknown"
+	Reason     string    `json:"reason,omitempty"`
+	OccurredAt time.Time `json:"occurredAt"`
+}
+
+// Hard reports whether the bounce is permanent
+func (e *DeliveryBouncedEvent) Hard() bool {
+	return e.BounceType == BounceHard
+}
+
+// DeliveryComplainedEvent is the payload of a delivery.complained event, sent
+// when a recipient marks an invitation as spam
+type DeliveryComplainedEvent struct {
+	InvitationID string           `json:"invitationId"`
+	DeliveryID   string           `json:"deliveryId"`
+	Target       InvitationTarget `json:"target"`
+	// FeedbackType is the complaint category reported by the mailbox
+	// provider, e.g. "abuse"
+	FeedbackType string    `json:"feedbackType,omitempty"`
+	OccurredAt   time.Time `json:"occurredAt"`
+}
+
+// InvitationReviewEvent is the payload of the invitation.approval_requested,
+// invitation.approved and invitation.rejected events
+type InvitationReviewEvent struct {
+	InvitationID string `json:"invitationId"`
+	Status       string `json:"status"`
+	// Reviewer is the admin who approved or rejected the invitation; nil for
+	// invitation.approval_requested
+	Reviewer   *Actor    `json:"reviewer,omitempty"`
+	OccurredAt time.Time `json:"occurredAt"`
+}
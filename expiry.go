@@ -0,0 +1,67 @@
+package vortex
+
+import "time"
+
+// BusinessDayEnd is the local hour EndOfBusinessDay expires invitations at
+const BusinessDayEnd = 17
+
+// EndOfBusinessDay returns the first business day close (17:00, Monday to
+// Friday) in loc after from. The time is built from the local calendar date
+// rather than by adding hours, so it stays at 17:00 across DST changes.
+//
+// Example:
+//
+//	loc, _ := time.LoadLocation("America/New_York")
+//	overrides.Expires = vortex.ExpiryTime(vortex.EndOfBusinessDay(time.Now(), loc))
+func EndOfBusinessDay(from time.Time, loc *time.Location) time.Time {
+	local := from.In(loc)
+	year, month, day := local.Date()
+	end := time.Date(year, month, day, BusinessDayEnd, 0, 0, 0, loc)
+	for !end.After(from) || end.Weekday() == time.Saturday || end.Weekday() == time.Sunday {
+		day++
+		end = time.Date(year, month, day, BusinessDayEnd, 0, 0, 0, loc)
+	}
+	return end
+}
+
+// ExpiryTime returns t in UTC to the second, as the API stores it, for
+// setting Expires
+func ExpiryTime(t time.Time) *time.Time {
+	expires := t.UTC().Truncate(time.Second)
+	return &expires
+}
+
+// FormatExpiry formats t as the UTC timestamp the API expects in Expires
+//
+// Deprecated: Expires is a *time.Time; use ExpiryTime. FormatExpiry remains
+// for code that sends the timestamp as a string, e.g. in Attributes.
+func FormatExpiry(t time.Time) *string {
+	formatted := t.UTC().Format(time.RFC3339)
+	return &formatted
+}
+
+// ExpiresAt returns Expires, or the zero time if the invitation does not
+// expire
+//
+// The error is always nil; it remains from when Expires was a string.
+func (r *InvitationResult) ExpiresAt() (time.Time, error) {
+	if r.Expires == nil {
+		return time.Time{}, nil
+	}
+	return *r.Expires, nil
+}
+
+// ExpiresIn returns Expires in loc, for showing the expiry in the invitee's
+// timezone; it is the zero time if the invitation does not expire
+//
+// Example:
+//
+//	expires, err := invitation.ExpiresIn(loc)
+//	fmt.Printf("Expires %s\n", expires.Format("Mon Jan 2, 3:04 PM MST"))
+func (r *InvitationResult) ExpiresIn(loc *time.Location) (time.Time, error) {
+	expires, err := r.ExpiresAt()
+	if err != nil || expires.IsZero() {
+		return expires, err
+	}
+	return expires.In(loc), nil
+}
@@ -0,0 +1,60 @@
+package vortex
+
+import (
+	"testing"
+	"time"
+	_ "time/tzdata"
+)
+
+func TestEndOfBusinessDay(t *testing.T) {
+	loc, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	tests := []struct {
+		name string
+		from time.Time
+		want string
+	}{
+		{"morning", time.Date(2026, 3, 4, 9, 0, 0, 0, loc), "2026-03-04T22:00:00Z"},
+		{"after close", time.Date(2026, 3, 4, 18, 0, 0, 0, loc), "2026-03-05T22:00:00Z"},
+		{"friday evening", time.Date(2026, 3, 6, 18, 0, 0, 0, loc), "2026-03-09T21:00:00Z"}, // DST starts Mar 8
+		{"weekend", time.Date(2026, 10, 31, 12, 0, 0, 0, loc), "2026-11-02T22:00:00Z"},      // DST ends Nov 1
+		{"utc input", time.Date(2026, 3, 4, 23, 0, 0, 0, time.UTC), "2026-03-05T22:00:00Z"},
+	}
+	for _, tt := range tests {
+		got := ExpiryTime(EndOfBusinessDay(tt.from, loc)).Format(time.RFC3339)
+		if got != tt.want {
+			t.Errorf("%s: Expected %s, got %s", tt.name, tt.want, got)
+		}
+	}
+}
+
+func TestExpiresIn(t *testing.T) {
+	loc, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	invitation := InvitationResult{Expires: ExpiryTime(time.Date(2026, 7, 1, 15, 0, 0, 0, time.UTC))}
+	expires, err := invitation.ExpiresIn(loc)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if got := expires.Format("15:04 MST"); got != "17:00 CEST" {
+		t.Errorf("Expected 17:00 CEST, got %s", got)
+	}
+
+	expires, err = (&InvitationResult{}).ExpiresIn(loc)
+	if err != nil || !expires.IsZero() {
+		t.Errorf("Expected zero time for no expiry, got %v, %v", expires, err)
+	}
+}
+
+func TestFormatExpiry(t *testing.T) {
+	got := FormatExpiry(time.Date(2026, 12, 1, 12, 0, 0, 0, time.FixedZone("EST", -5*3600)))
+	if *got != "2026-12-01T17:00:00Z" {
+		t.Errorf("Expected 2026-12-01T17:00:00Z, got %s", *got)
+	}
+}
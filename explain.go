@@ -0,0 +1,88 @@
+package vortex
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"strings"
+
+	"github.com/TeamVortexSoftware/vortex-go-sdk/vortexjwt"
+)
+
+// ExplainError returns human-friendly guidance for an error returned by the SDK
+//
+// API errors are mapped by status code and endpoint to a short explanation of
+// the likely cause and what to try next. Other errors are returned as-is with
+// guidance for the common network and API key problems. It returns an empty
+// string for a nil error.
+//
+// Example:
+//
+//	if _, err := client.AcceptInvitations(ids, target); err != nil {
+//	    log.Printf("accept failed: %s", vortex.ExplainError(err))
+//	}
+func ExplainError(err error) string {
+	if err == nil {
+		return ""
+	}
+
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		return fmt.Sprintf("%s: %s", apiErr.Message, explainAPIError(apiErr))
+	}
+
+	var netErr net.Error
+	if errors.As(err, &netErr) && netErr.Timeout() {
+		return fmt.Sprintf("%s: the Vortex API did not respond in time; check connectivity to the API and retry, or raise the HTTP client timeout", err)
+	}
+
+	var keyErr *vortexjwt.APIKeyError
+	if errors.As(err, &keyErr) {
+		return fmt.Sprintf("%s: API keys look like VRTX.<id>.<secret>; make sure the full key from the Vortex dashboard is configured", err)
+	}
+
+	var urlErr *url.Error
+	if errors.As(err, &urlErr) {
+		return fmt.Sprintf("%s: the Vortex API could not be reached; check network access and VORTEX_API_BASE_URL", err)
+	}
+
+	return err.Error()
+}
+
+func explainAPIError(e *APIError) string {
+	isAccept := strings.HasSuffix(e.Path, "/accept")
+	isGroup := strings.Contains(e.Path, "/by-group/")
+	isReinvite := strings.HasSuffix(e.Path, "/reinvite")
+
+	switch {
+	case e.StatusCode == 400:
+		return "the API rejected the request as malformed; check target types, values and IDs"
+	case e.StatusCode == 401:
+		return "the API key was not accepted; check that VORTEX_API_KEY is set and has not been rotated"
+	case e.StatusCode == 403:
+		return "the API key is not allowed to access this resource; it may belong to a different project"
+	case e.StatusCode == 404 && isAccept:
+		return "the invitation was not found, which usually means it was already revoked or deleted"
+	case e.StatusCode == 404 && isReinvite:
+		return "the invitation was not found; it may have been revoked, so create a new invitation instead"
+	case e.StatusCode == 404 && isGroup:
+		return "no invitations exist for this group; check the group type and the customer group ID"
+	case e.StatusCode == 404:
+		return "the resource was not found; check the ID and that it belongs to the project of this API key"
+	case e.StatusCode == 409 && isAccept:
+		return "the invitation has already been accepted or is no longer pending"
+	case e.StatusCode == 409:
+		return "the request conflicts with the current state of the resource; fetch it again and retry"
+	case e.StatusCode == 410:
+		return "the invitation has expired or been deactivated; send a new invitation"
+	case e.StatusCode == 422:
+		return "the request failed validation; see the error details for the fields that need fixing"
+	case e.StatusCode == 429:
+		return "too many requests were sent; slow down and retry after a short delay"
+	case e.StatusCode >= 500:
+		return "the Vortex API had an internal problem; retry later and contact support if it persists"
+	}
+
+	return "unexpected response from the Vortex API; see the error details"
+}
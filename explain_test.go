@@ -0,0 +1,40 @@
+package vortex
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestExplainError(t *testing.T) {
+	_, invalidKeyErr := NewClient("not-a-key").GenerateJWT(&User{ID: "user-123"}, nil)
+	_, unreachableErr := NewClientWithOptions("test-api-key", "http://127.0.0.1:0", nil).GetInvitation("inv-1")
+
+	tests := []struct {
+		name     string
+		err      error
+		contains string
+	}{
+		{"nil", nil, ""},
+		{"accept not found", &APIError{StatusCode: 404, Message: "failed", Path: "/api/v1/invitations/accept"}, "already revoked"},
+		{"group not found", &APIError{StatusCode: 404, Message: "failed", Path: "/api/v1/invitations/by-group/team/t1"}, "group"},
+		{"unauthorized", &APIError{StatusCode: 401, Message: "failed"}, "VORTEX_API_KEY"},
+		{"rate limited", &APIError{StatusCode: 429, Message: "failed"}, "slow down"},
+		{"server error", &APIError{StatusCode: 503, Message: "failed"}, "retry later"},
+		{"invalid key", invalidKeyErr, "VRTX."},
+		{"unreachable", unreachableErr, "could not be reached"},
+		{"other", errors.New("boom"), "boom"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ExplainError(tt.err)
+			if tt.err == nil && got != "" {
+				t.Fatalf("Expected empty explanation for nil error, got %q", got)
+			}
+			if !strings.Contains(got, tt.contains) {
+				t.Errorf("Expected explanation to contain %q, got %q", tt.contains, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,114 @@
+package vortex
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+const fileCacheExt = ".vortexcache"
+
+// FileCache is a Cache persisting entries as files in a directory, so cached
+// reads survive restarts of CLIs and batch jobs
+//
+// Entries are served for ttl. Expired entries stay on disk until overwritten
+// or cleared, so FileCache implements StaleCache for WithStaleReads. It is
+// safe for concurrent use, including by several processes sharing the
+// directory.
+type FileCache struct {
+	dir string
+	ttl time.Duration
+}
+
+type fileCacheEntry struct {
+	Key      string    `json:"key"`
+	Value    []byte    `json:"value"`
+	StoredAt time.Time `json:"storedAt"`
+}
+
+// NewFileCache creates a FileCache in dir, creating the directory if needed
+//
+// Example:
+//
+//	dir, _ := os.UserCacheDir()
+//	cache, err := vortex.NewFileCache(filepath.Join(dir, "vortex"), 5*time.Minute)
+//	if err != nil {
+//	    return err
+//	}
+//	client := vortex.NewClient(apiKey, vortex.WithCache(cache), vortex.WithStaleReads(24*time.Hour))
+func NewFileCache(dir string, ttl time.Duration) (*FileCache, error) {
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return nil, fmt.Errorf("failed to create cache directory: %w", err)
+	}
+	return &FileCache{dir: dir, ttl: ttl}, nil
+}
+
+// Get implements Cache
+func (c *FileCache) Get(key string) ([]byte, bool) {
+	value, storedAt, ok := c.GetStale(key)
+	if !ok || time.Since(storedAt) > c.ttl {
+		return nil, false
+	}
+	return value, true
+}
+
+// GetStale implements StaleCache
+func (c *FileCache) GetStale(key string) ([]byte, time.Time, bool) {
+	data, err := os.ReadFile(c.path(key))
+	if err != nil {
+		return nil, time.Time{}, false
+	}
+
+	var entry fileCacheEntry
+	if json.Unmarshal(data, &entry) != nil || entry.Key != key {
+		return nil, time.Time{}, false
+	}
+	return entry.Value, entry.StoredAt, true
+}
+
+// Set implements Cache
+func (c *FileCache) Set(key string, value []byte) {
+	data, err := json.Marshal(fileCacheEntry{Key: key, Value: value, StoredAt: time.Now()})
+	if err != nil {
+		return
+	}
+
+	// Write to a temporary file and rename it so readers never see a partial entry
+	tmp, err := os.CreateTemp(c.dir, "tmp-*")
+	if err != nil {
+		return
+	}
+	_, writeErr := tmp.Write(data)
+	closeErr := tmp.Close()
+	if writeErr != nil || closeErr != nil || os.Rename(tmp.Name(), c.path(key)) != nil {
+		os.Remove(tmp.Name())
+	}
+}
+
+// Delete implements Cache
+func (c *FileCache) Delete(key string) {
+	os.Remove(c.path(key))
+}
+
+// Clear implements Cache
+func (c *FileCache) Clear() {
+	entries, err := os.ReadDir(c.dir)
+	if err != nil {
+		return
+	}
+	for _, entry := range entries {
+		if strings.HasSuffix(entry.Name(), fileCacheExt) {
+			os.Remove(filepath.Join(c.dir, entry.Name()))
+		}
+	}
+}
+
+func (c *FileCache) path(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return filepath.Join(c.dir, hex.EncodeToString(sum[:])+fileCacheExt)
+}
@@ -0,0 +1,101 @@
+package vortex
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestFileCache(t *testing.T) {
+	dir := t.TempDir()
+	cache, err := NewFileCache(dir, time.Minute)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	cache.Set("invitation:inv-1", []byte(`{"id":"inv-1"}`))
+	cache.Set("target:email:a@example.com", []byte(`{"invitations":[]}`))
+
+	// A second cache on the same directory sees the entries
+	reopened, _ := NewFileCache(dir, time.Minute)
+	if value, ok := reopened.Get("invitation:inv-1"); !ok || string(value) != `{"id":"inv-1"}` {
+		t.Errorf("Expected persisted entry, got %s, %v", value, ok)
+	}
+
+	cache.Delete("invitation:inv-1")
+	if _, ok := cache.Get("invitation:inv-1"); ok {
+		t.Error("Expected deleted entry to be gone")
+	}
+
+	cache.Clear()
+	if _, ok := cache.Get("target:email:a@example.com"); ok {
+		t.Error("Expected cleared entry to be gone")
+	}
+}
+
+func TestFileCache_Expiry(t *testing.T) {
+	cache, _ := NewFileCache(t.TempDir(), -time.Second)
+
+	cache.Set("invitation:inv-1", []byte(`{"id":"inv-1"}`))
+	if _, ok := cache.Get("invitation:inv-1"); ok {
+		t.Error("Expected expired entry not to be served")
+	}
+	if value, _, ok := cache.GetStale("invitation:inv-1"); !ok || string(value) != `{"id":"inv-1"}` {
+		t.Errorf("Expected expired entry to be available as stale, got %s, %v", value, ok)
+	}
+}
+
+func TestWithStaleReads(t *testing.T) {
+	down := false
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if down {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		if r.URL.Path == "/api/v1/invitations" {
+			w.Write([]byte(`{"invitations": [{"id": "inv-1"}]}`))
+			return
+		}
+		w.Write([]byte(`{"id": "inv-1", "status": "pending"}`))
+	}))
+	defer server.Close()
+
+	// Entries expire immediately, so every read goes to the API first
+	cache, _ := NewFileCache(t.TempDir(), -time.Second)
+	client := NewClientWithOptions("test-api-key", server.URL, nil, WithCache(cache), WithStaleReads(time.Hour))
+
+	if _, err := client.GetInvitation("inv-1"); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if _, err := client.GetInvitationsByTarget("email", "a@example.com"); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	down = true
+
+	invitation, err := client.GetInvitation("inv-1")
+	var stale *StaleError
+	if !errors.As(err, &stale) {
+		t.Fatalf("Expected a StaleError, got %v", err)
+	}
+	if invitation == nil || invitation.Status != "pending" {
+		t.Errorf("Expected the stale invitation, got %+v", invitation)
+	}
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != 503 {
+		t.Errorf("Expected the StaleError to wrap the 503, got %v", err)
+	}
+
+	invitations, err := client.GetInvitationsByTarget("email", "a@example.com")
+	if !errors.As(err, &stale) || len(invitations) != 1 {
+		t.Errorf("Expected the stale list, got %v, %v", invitations, err)
+	}
+
+	// Without stale reads the outage is an ordinary error
+	client = NewClientWithOptions("test-api-key", server.URL, nil, WithCache(cache))
+	if invitation, err := client.GetInvitation("inv-1"); invitation != nil || errors.As(err, &stale) {
+		t.Errorf("Expected a plain error, got %+v, %v", invitation, err)
+	}
+}
@@ -0,0 +1,79 @@
+package vortex
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// GraphQLError is returned when a GraphQL response contains errors
+type GraphQLError struct {
+	Errors []GraphQLErrorEntry `json:"errors"`
+}
+
+// GraphQLErrorEntry is a single error reported by the GraphQL endpoint
+type GraphQLErrorEntry struct {
+	Message    string                 `json:"message"`
+	Path       []interface{}          `json:"path,omitempty"`
+	Extensions map[string]interface{} `json:"extensions,omitempty"`
+}
+
+func (e *GraphQLError) Error() string {
+	messages := make([]string, len(e.Errors))
+	for i, entry := range e.Errors {
+		messages[i] = entry.Message
+	}
+	return "Vortex GraphQL request failed: " + strings.Join(messages, "; ")
+}
+
+// GraphQL runs a query against the Vortex GraphQL endpoint and decodes the
+// response data into out
+//
+// Selecting only the fields you need keeps responses much smaller than the
+// full InvitationResult returned by the REST methods. If the response contains
+// errors, any partial data is still decoded into out and a *GraphQLError is
+// returned.
+//
+// Example:
+//
+//	var out struct {
+//	    Invitation struct {
+//	        ID     string `json:"id"`
+//	        Status string `json:"status"`
+//	    } `json:"invitation"`
+//	}
+//	err := client.GraphQL(ctx, `query($id: ID!) { invitation(id: $id) { id status } }`,
+//	    map[string]interface{}{"id": invitationID}, &out)
+func (c *Client) GraphQL(ctx context.Context, query string, vars map[string]interface{}, out interface{}, opts ...RequestOption) error {
+	requestBody := map[string]interface{}{
+		"query": query,
+	}
+	if vars != nil {
+		requestBody["variables"] = vars
+	}
+
+	responseBody, err := c.apiRequestContext(ctx, "POST", "/api/v1/graphql", requestBody, nil, opts...)
+	if err != nil {
+		return err
+	}
+
+	var response struct {
+		Data   json.RawMessage     `json:"data"`
+		Errors []GraphQLErrorEntry `json:"errors"`
+	}
+	if err := json.Unmarshal(responseBody, &response); err != nil {
+		return fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	if out != nil && len(response.Data) > 0 && string(response.Data) != "null" {
+		if err := json.Unmarshal(response.Data, out); err != nil {
+			return fmt.Errorf("failed to unmarshal GraphQL data: %w", err)
+		}
+	}
+
+	if len(response.Errors) > 0 {
+		return &GraphQLError{Errors: response.Errors}
+	}
+	return nil
+}
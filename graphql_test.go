@@ -0,0 +1,69 @@
+package vortex
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestGraphQL(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "POST" || r.URL.Path != "/api/v1/graphql" {
+			t.Errorf("Expected POST /api/v1/graphql, got %s %s", r.Method, r.URL.Path)
+		}
+
+		var req struct {
+			Query     string                 `json:"query"`
+			Variables map[string]interface{} `json:"variables"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Errorf("Failed to decode request body: %v", err)
+		}
+		if req.Variables["id"] != "inv-1" {
+			t.Errorf("Expected variable id to be 'inv-1', got %v", req.Variables["id"])
+		}
+
+		w.Write([]byte(`{"data": {"invitation": {"id": "inv-1", "status": "pending"}}}`))
+	}))
+	defer server.Close()
+
+	client := NewClientWithOptions("test-api-key", server.URL, nil)
+
+	var out struct {
+		Invitation struct {
+			ID     string `json:"id"`
+			Status string `json:"status"`
+		} `json:"invitation"`
+	}
+	err := client.GraphQL(context.Background(), `query($id: ID!) { invitation(id: $id) { id status } }`,
+		map[string]interface{}{"id": "inv-1"}, &out)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if out.Invitation.ID != "inv-1" || out.Invitation.Status != "pending" {
+		t.Errorf("Unexpected data: %+v", out)
+	}
+}
+
+func TestGraphQL_Errors(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"data": null, "errors": [{"message": "Cannot query field \"bogus\""}]}`))
+	}))
+	defer server.Close()
+
+	client := NewClientWithOptions("test-api-key", server.URL, nil)
+
+	err := client.GraphQL(context.Background(), `{ bogus }`, nil, nil)
+
+	var gqlErr *GraphQLError
+	if !errors.As(err, &gqlErr) {
+		t.Fatalf("Expected GraphQLError, got %T", err)
+	}
+	if len(gqlErr.Errors) != 1 {
+		t.Errorf("Expected 1 error, got %d", len(gqlErr.Errors))
+	}
+}
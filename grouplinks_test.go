@@ -0,0 +1,67 @@
+package vortex
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestGroupInviteLinks(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == "POST" && r.URL.Path == "/api/v1/groups/team/team-1/invite-links":
+			var req map[string]interface{}
+			if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+				t.Errorf("Failed to decode request body: %v", err)
+			}
+			if req["maxUses"] != float64(25) || req["expiresInSeconds"] != float64(604800) {
+				t.Errorf("Expected maxUses 25 and a 7 day expiry, got %v", req)
+			}
+			w.Write([]byte(`{"id": "link-1", "url": "https://vrtx.link/join/abc", "maxUses": 25}`))
+		case r.Method == "GET" && r.URL.Path == "/api/v1/groups/team/team-1/invite-links":
+			w.Write([]byte(`{"links": [{"id": "link-1", "url": "https://vrtx.link/join/abc", "maxUses": 25, "uses": 3}]}`))
+		case r.Method == "POST" && r.URL.Path == "/api/v1/invite-links/link-1/rotate":
+			w.Write([]byte(`{"id": "link-1", "url": "https://vrtx.link/join/def", "maxUses": 25, "uses": 3}`))
+		case r.Method == "DELETE" && r.URL.Path == "/api/v1/invite-links/link-1":
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("Unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClientWithOptions("test-api-key", server.URL, nil)
+	ctx := context.Background()
+	group := GroupRef{Type: "team", GroupID: "team-1"}
+
+	link, err := client.CreateGroupInviteLink(ctx, group, GroupInviteLinkOptions{MaxUses: 25, Expiry: 7 * 24 * time.Hour})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if link.URL != "https://vrtx.link/join/abc" {
+		t.Errorf("Expected link URL, got %s", link.URL)
+	}
+
+	links, err := client.ListGroupInviteLinks(ctx, group)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if len(links) != 1 || links[0].Uses != 3 {
+		t.Errorf("Expected 1 link used 3 times, got %+v", links)
+	}
+
+	rotated, err := client.RotateGroupInviteLink(ctx, "link-1")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if rotated.URL == link.URL {
+		t.Error("Expected rotation to change the URL")
+	}
+
+	if err := client.RevokeGroupInviteLink(ctx, "link-1"); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+}
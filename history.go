@@ -0,0 +1,53 @@
+package vortex
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+)
+
+// InvitationHistoryEvent is one step in an invitation's lifecycle
+type InvitationHistoryEvent struct {
+	ID         string           `json:"id"`
+	Type       HistoryEventType `json:"type"`
+	OccurredAt time.Time        `json:"occurredAt"`
+	// Actor is who caused the event, e.g. the admin who revoked the
+	// invitation, if known
+	Actor *Actor `json:"actor,omitempty"`
+	// DeliveryID is the delivery a sent, viewed or clicked event belongs to
+	DeliveryID string `json:"deliveryId,omitempty"`
+	// Metadata holds details specific to the event type, e.g. the user agent
+	// of a click
+	Metadata map[string]interface{} `json:"metadata,omitempty"`
+}
+
+// GetInvitationEvents returns an invitation's history, oldest first, for
+// reconstructing its lifecycle
+//
+// Example:
+//
+//	events, err := client.GetInvitationEvents(ctx, invitationID)
+//	for _, event := range events {
+//	    fmt.Printf("%s %s\n", event.OccurredAt.Format(time.RFC3339), event.Type)
+//	}
+func (c *Client) GetInvitationEvents(ctx context.Context, invitationID string, opts ...RequestOption) ([]InvitationHistoryEvent, error) {
+	path := fmt.Sprintf("/api/v1/invitations/%s/events", invitationID)
+
+	responseBody, err := c.apiRequestContext(ctx, "GET", path, nil, nil, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	var response struct {
+		Events []InvitationHistoryEvent `json:"events"`
+	}
+	if err := c.decode(responseBody, &response); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	sort.SliceStable(response.Events, func(i, j int) bool {
+		return response.Events[i].OccurredAt.Before(response.Events[j].OccurredAt)
+	})
+	return response.Events, nil
+}
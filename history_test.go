@@ -0,0 +1,46 @@
+package vortex
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestGetInvitationEvents(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "GET" {
+			t.Errorf("Expected GET, got %s", r.Method)
+		}
+		if r.URL.Path != "/api/v1/invitations/inv-1/events" {
+			t.Errorf("Expected /api/v1/invitations/inv-1/events, got %s", r.URL.Path)
+		}
+		w.Write([]byte(`{"events": [
+			{"id": "ev-3", "type": "accepted", "occurredAt": "2024-01-03T00:00:00Z", "actor": {"id": "user-2"}},
+			{"id": "ev-1", "type": "sent", "occurredAt": "2024-01-01T00:00:00Z", "deliveryId": "d-1"},
+			{"id": "ev-2", "type": "clicked", "occurredAt": "2024-01-02T00:00:00Z", "metadata": {"userAgent": "Mozilla/5.0"}}
+		]}`))
+	}))
+	defer server.Close()
+
+	client := NewClientWithOptions("test-api-key", server.URL, nil)
+
+	events, err := client.GetInvitationEvents(context.Background(), "inv-1")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if len(events) != 3 {
+		t.Fatalf("Expected 3 events, got %d", len(events))
+	}
+	for i, want := range []HistoryEventType{HistorySent, HistoryClicked, HistoryAccepted} {
+		if events[i].Type != want {
+			t.Errorf("Expected event %d to be %s, got %s", i, want, events[i].Type)
+		}
+	}
+	if events[0].DeliveryID != "d-1" {
+		t.Errorf("Expected delivery d-1, got %s", events[0].DeliveryID)
+	}
+	if events[2].Actor == nil || events[2].Actor.ID != "user-2" {
+		t.Errorf("Expected actor user-2, got %+v", events[2].Actor)
+	}
+}
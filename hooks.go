@@ -0,0 +1,19 @@
+package vortex
+
+import "context"
+
+// OnError calls hook with every error returned by an API request, such as an
+// *APIError or a network failure, e.g. to report it to an error tracker
+//
+// The hook runs synchronously before the error is returned to the caller.
+//
+// Example:
+//
+//	client := vortex.NewClient(apiKey, vortex.OnError(func(ctx context.Context, err error) {
+//	    log.Printf("vortex: %v", err)
+//	}))
+func OnError(hook func(ctx context.Context, err error)) ClientOption {
+	return func(c *Client) {
+		c.onError = hook
+	}
+}
@@ -0,0 +1,44 @@
+package vortex
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestOnError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/v1/invitations/missing" {
+			w.Header().Set("X-Request-Id", "req-123")
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Write([]byte(`{"id":"inv-1"}`))
+	}))
+	defer server.Close()
+
+	var reported []error
+	client := NewClientWithOptions("test-api-key", server.URL, nil, OnError(func(ctx context.Context, err error) {
+		reported = append(reported, err)
+	}))
+
+	client.GetInvitation("inv-1")
+	client.GetInvitation("missing")
+
+	if len(reported) != 1 {
+		t.Fatalf("Expected 1 reported error, got %d", len(reported))
+	}
+
+	var apiErr *APIError
+	if !errors.As(reported[0], &apiErr) {
+		t.Fatalf("Expected APIError, got %v", reported[0])
+	}
+	if apiErr.RequestID != "req-123" {
+		t.Errorf("Expected request ID 'req-123', got %s", apiErr.RequestID)
+	}
+	if apiErr.Path != "/api/v1/invitations/missing" {
+		t.Errorf("Expected path '/api/v1/invitations/missing', got %s", apiErr.Path)
+	}
+}
@@ -0,0 +1,97 @@
+package vortex
+
+import (
+	"context"
+	"io"
+	"time"
+)
+
+// VortexClient is the set of API methods of *Client, for code that wants to
+// accept a mock in tests; vortextest.MockClient implements it
+//
+// AsUser is left out since it returns a *Client.
+type VortexClient interface {
+	AcceptInvitations(invitationIDs []string, target InvitationTarget, opts ...RequestOption) (*InvitationResult, error)
+	AcceptInvitationsAsAdmin(ctx context.Context, invitationIDs []string, target InvitationTarget, actor Actor, opts ...RequestOption) (*InvitationResult, error)
+	AcceptInvitationsBatch(ctx context.Context, invitationIDs []string, target InvitationTarget, opts ...RequestOption) ([]AcceptResult, error)
+	AcceptInvitationsContext(ctx context.Context, invitationIDs []string, target InvitationTarget, opts ...RequestOption) (*InvitationResult, error)
+	AddGroupMembers(ctx context.Context, groupType, groupID string, members []Member, opts ...RequestOption) error
+	AddInvitationTags(ctx context.Context, invitationID string, tags ...string) (*InvitationResult, error)
+	ApproveInvitation(ctx context.Context, invitationID string, reviewer Actor, opts ...RequestOption) (*InvitationResult, error)
+	ArchiveInvitation(ctx context.Context, invitationID string, opts ...RequestOption) (*InvitationResult, error)
+	AttachFileToInvitation(ctx context.Context, invitationID, filename string, r io.Reader, opts ...AttachmentOption) (*Attachment, error)
+	CancelReminder(ctx context.Context, invitationID, reminderID string, opts ...RequestOption) error
+	CloneInvitation(ctx context.Context, invitationID string, overrides InvitationOverrides, opts ...RequestOption) (*InvitationResult, error)
+	CreateGroupInviteLink(ctx context.Context, group GroupRef, options GroupInviteLinkOptions, opts ...RequestOption) (*GroupInviteLink, error)
+	CreateInvitation(ctx context.Context, req CreateInvitationRequest, opts ...RequestOption) (*InvitationResult, error)
+	DeleteGroupPolicy(ctx context.Context, group GroupRef, opts ...RequestOption) error
+	DeleteInvitationsByGroup(groupType, groupID string, opts ...RequestOption) error
+	DeleteInvitationsByGroupContext(ctx context.Context, groupType, groupID string, opts ...RequestOption) error
+	Do(ctx context.Context, req Request) (*Response, error)
+	DoJSON(ctx context.Context, method, path string, body, out interface{}, opts ...RequestOption) error
+	EraseTargetData(ctx context.Context, target InvitationTarget, opts ...RequestOption) (*ErasureReport, error)
+	ExportTargetData(ctx context.Context, target InvitationTarget, w io.Writer, opts ...RequestOption) error
+	GenerateJWT(user *User, extra map[string]interface{}) (string, error)
+	GenerateJWTWithOptions(user *User, extra map[string]interface{}, opts JWTOptions) (string, error)
+	GetGroupPolicy(ctx context.Context, group GroupRef, opts ...RequestOption) (*GroupPolicy, error)
+	GetInvitation(invitationID string, opts ...RequestOption) (*InvitationResult, error)
+	GetInvitationContext(ctx context.Context, invitationID string, opts ...RequestOption) (*InvitationResult, error)
+	GetInvitationDeliveries(ctx context.Context, invitationID string, opts ...RequestOption) ([]Delivery, error)
+	GetInvitationEvents(ctx context.Context, invitationID string, opts ...RequestOption) ([]InvitationHistoryEvent, error)
+	GetInvitationLimits(ctx context.Context, opts ...RequestOption) (*InvitationLimits, error)
+	GetInvitationLink(ctx context.Context, invitationID string, opts ...RequestOption) (string, error)
+	GetInvitationStats(ctx context.Context, filters StatsOptions, opts ...RequestOption) (*InvitationStats, error)
+	GetInvitationTimeSeries(ctx context.Context, metric, interval string, filters TimeSeriesFilters, opts ...RequestOption) ([]TimeSeriesBucket, error)
+	GetInvitationsByGroup(groupType, groupID string, opts ...ListOption) ([]InvitationResult, error)
+	GetInvitationsByGroupContext(ctx context.Context, groupType, groupID string, opts ...ListOption) ([]InvitationResult, error)
+	GetInvitationsByTarget(targetType, targetValue string, opts ...ListOption) ([]InvitationResult, error)
+	GetInvitationsByTargetContext(ctx context.Context, targetType, targetValue string, opts ...ListOption) ([]InvitationResult, error)
+	GetInvitationsForTarget(ctx context.Context, target InvitationTarget, opts ...ListOption) ([]InvitationResult, error)
+	GetLandingPage(ctx context.Context, widgetConfigurationID string, opts ...RequestOption) (*LandingPage, error)
+	GetSMSSettings(ctx context.Context, opts ...RequestOption) (*SMSSettings, error)
+	GetSeatUsage(ctx context.Context, group GroupRef, opts ...RequestOption) (*SeatUsage, error)
+	GetThrottleSettings(ctx context.Context, opts ...RequestOption) (*ThrottleSettings, error)
+	GetThrottleState(ctx context.Context, inviterID string, opts ...RequestOption) (*ThrottleState, error)
+	GetVariantMetrics(ctx context.Context, widgetConfigurationID string, opts ...RequestOption) ([]VariantMetrics, error)
+	GetWidgetBootstrap(ctx context.Context, user *User, group GroupRef, opts ...RequestOption) (*WidgetBootstrap, error)
+	GraphQL(ctx context.Context, query string, vars map[string]interface{}, out interface{}, opts ...RequestOption) error
+	LastRateLimit() *RateLimit
+	LastResponse() *ResponseMetadata
+	ListGroupInviteLinks(ctx context.Context, group GroupRef, opts ...RequestOption) ([]GroupInviteLink, error)
+	ListGroupMembers(ctx context.Context, groupType, groupID string, opts ...RequestOption) *MemberIterator
+	ListInvitations(ctx context.Context, opts ListOptions) *InvitationIterator
+	ListReminders(ctx context.Context, invitationID string, opts ...RequestOption) ([]Reminder, error)
+	ListTemplateLocalizations(ctx context.Context, templateID string, opts ...RequestOption) ([]TemplateLocalization, error)
+	MoveInvitation(ctx context.Context, invitationID string, fromGroup, toGroup GroupRef, opts ...RequestOption) (*InvitationResult, error)
+	PatchInvitationAttributes(ctx context.Context, invitationID string, attrs map[string]interface{}, opts ...RequestOption) (*InvitationResult, error)
+	PreviewInvitationEmail(ctx context.Context, templateID string, sampleData map[string]interface{}, opts ...RequestOption) (*EmailPreview, error)
+	Reinvite(invitationID string, opts ...RequestOption) (*InvitationResult, error)
+	ReinviteContext(ctx context.Context, invitationID string, opts ...RequestOption) (*InvitationResult, error)
+	ReinviteMany(ctx context.Context, invitationIDs []string, opts ...RequestOption) (*BulkResult, error)
+	RejectInvitation(ctx context.Context, invitationID string, reviewer Actor, opts ...RequestOption) (*InvitationResult, error)
+	RemoveGroupMember(ctx context.Context, groupType, groupID, userID string, opts ...RequestOption) error
+	RemoveInvitationTags(ctx context.Context, invitationID string, tags ...string) (*InvitationResult, error)
+	RemoveSuppression(ctx context.Context, target InvitationTarget, opts ...RequestOption) error
+	RestoreInvitation(ctx context.Context, invitationID string, opts ...RequestOption) (*InvitationResult, error)
+	RetryDelivery(ctx context.Context, invitationID, deliveryID string, opts ...RequestOption) (*Delivery, error)
+	RevokeAllForTarget(ctx context.Context, target InvitationTarget, opts ...RequestOption) ([]string, error)
+	RevokeGroupInviteLink(ctx context.Context, linkID string, opts ...RequestOption) error
+	RevokeInvitation(invitationID string, opts ...RequestOption) error
+	RevokeInvitationContext(ctx context.Context, invitationID string, opts ...RequestOption) error
+	RevokeInvitations(ctx context.Context, invitationIDs []string, opts ...RequestOption) (*BulkResult, error)
+	RotateGroupInviteLink(ctx context.Context, linkID string, opts ...RequestOption) (*GroupInviteLink, error)
+	ScheduleReminders(ctx context.Context, invitationID string, delays ...time.Duration) ([]Reminder, error)
+	SetGroupPolicy(ctx context.Context, group GroupRef, policy GroupPolicy, opts ...RequestOption) (*GroupPolicy, error)
+	SetReferralRewardState(ctx context.Context, invitationID, state string, opts ...RequestOption) (*InvitationResult, error)
+	ShortenLink(ctx context.Context, longURL string, opts ...RequestOption) (string, error)
+	StreamEvents(ctx context.Context, opts StreamOptions) (<-chan Event, error)
+	SuppressHardBounce(ctx context.Context, event DeliveryBouncedEvent, opts ...RequestOption) (bool, error)
+	SuppressTarget(ctx context.Context, target InvitationTarget, reason string, opts ...RequestOption) error
+	UpdateLandingPage(ctx context.Context, widgetConfigurationID string, page LandingPage, opts ...RequestOption) (*LandingPage, error)
+	UpdateSMSSettings(ctx context.Context, settings SMSSettings, opts ...RequestOption) (*SMSSettings, error)
+	UpdateThrottleSettings(ctx context.Context, settings ThrottleSettings, opts ...RequestOption) (*ThrottleSettings, error)
+	Use(middleware ...Middleware)
+	VerifyJWT(token string) (*JWTClaims, error)
+}
+
+var _ VortexClient = (*Client)(nil)
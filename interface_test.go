@@ -0,0 +1,22 @@
+package vortex
+
+import (
+	"reflect"
+	"testing"
+)
+
+// TestVortexClientCoversClient keeps VortexClient in step with new methods
+func TestVortexClientCoversClient(t *testing.T) {
+	iface := reflect.TypeOf((*VortexClient)(nil)).Elem()
+	client := reflect.TypeOf(&Client{})
+
+	for i := 0; i < client.NumMethod(); i++ {
+		name := client.Method(i).Name
+		if name == "AsUser" {
+			continue
+		}
+		if _, ok := iface.MethodByName(name); !ok {
+			t.Errorf("Expected VortexClient to include %s", name)
+		}
+	}
+}
@@ -4,53 +4,63 @@
 package vortex
 
 import (
-	"fmt"
-	"os"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"strings"
+	"testing"
 )
 
-// TestJWTVerify is a simple program to test JWT generation
-// Run with: go run jwt_verify_test.go <api-key>
-func TestJWTVerify() {
-	if len(os.Args) < 2 {
-		fmt.Fprintln(os.Stderr, "Usage: go test -run=TestJWTVerify <api-key>")
-		os.Exit(1)
+// TestJWTVerify checks that generated JWTs carry a valid HS256 signature
+// derived from the API key and the expected payload claims
+func TestJWTVerify(t *testing.T) {
+	client := NewClient("VRTX.EjRWeBI0EjQSNBI0VniQEg.test-key")
+
+	user := &User{
+		ID:          "test-user-123",
+		Email:       "test@example.com",
+		AdminScopes: []string{"autojoin"},
+	}
+	jwt, err := client.GenerateJWT(user, map[string]interface{}{"role": "admin"})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
 	}
 
-	apiKey := os.Args[1]
-	client := NewClient(apiKey)
+	parts := strings.Split(jwt, ".")
+	if len(parts) != 3 {
+		t.Fatalf("JWT should have 3 parts separated by dots, got %d", len(parts))
+	}
 
-	// Test simplified format with admin flag
-	boolTrue := true
-	jwtSimpleWithAdmin, err := client.GenerateJWTSimple("test-user-123", "test@example.com", &boolTrue)
-	if err != nil {
-		fmt.Fprintf(os.Stderr, "Error generating JWT (simple with admin): %v\n", err)
-		os.Exit(1)
+	// Re-derive the signing key from the API key and the decoded UUID
+	signingKeyHmac := hmac.New(sha256.New, []byte("test-key"))
+	signingKeyHmac.Write([]byte("12345678-1234-1234-1234-123456789012"))
+	signatureHmac := hmac.New(sha256.New, signingKeyHmac.Sum(nil))
+	signatureHmac.Write([]byte(parts[0] + "." + parts[1]))
+	expected := base64.RawURLEncoding.EncodeToString(signatureHmac.Sum(nil))
+	if parts[2] != expected {
+		t.Errorf("Expected signature %s, got %s", expected, parts[2])
 	}
-	fmt.Printf("SIMPLE_WITH_ADMIN:%s\n", jwtSimpleWithAdmin)
 
-	// Test simplified format without admin flag
-	jwtSimpleNoAdmin, err := client.GenerateJWTSimple("test-user-123", "test@example.com", nil)
-	if err != nil {
-		fmt.Fprintf(os.Stderr, "Error generating JWT (simple no admin): %v\n", err)
-		os.Exit(1)
-	}
-	fmt.Printf("SIMPLE_NO_ADMIN:%s\n", jwtSimpleNoAdmin)
-
-	// Test legacy format
-	role := "admin"
-	jwtLegacy, err := client.GenerateJWT(JWTPayload{
-		UserID: "test-user-123",
-		Identifiers: []Identifier{
-			{Type: "email", Value: "test@example.com"},
-		},
-		Groups: []Group{
-			{Type: "team", GroupID: "team-123", Name: "Test Team"},
-		},
-		Role: &role,
-	})
+	payloadJSON, err := base64.RawURLEncoding.DecodeString(parts[1])
 	if err != nil {
-		fmt.Fprintf(os.Stderr, "Error generating JWT (legacy): %v\n", err)
-		os.Exit(1)
+		t.Fatalf("Failed to decode payload: %v", err)
+	}
+	var payload map[string]interface{}
+	if err := json.Unmarshal(payloadJSON, &payload); err != nil {
+		t.Fatalf("Failed to unmarshal payload: %v", err)
+	}
+
+	if payload["userId"] != "test-user-123" {
+		t.Errorf("Expected userId to be 'test-user-123', got %v", payload["userId"])
+	}
+	if payload["userEmail"] != "test@example.com" {
+		t.Errorf("Expected userEmail to be 'test@example.com', got %v", payload["userEmail"])
+	}
+	if payload["role"] != "admin" {
+		t.Errorf("Expected role to be 'admin', got %v", payload["role"])
+	}
+	if _, ok := payload["expires"]; !ok {
+		t.Error("Expected expires claim to be present")
 	}
-	fmt.Printf("LEGACY:%s\n", jwtLegacy)
 }
@@ -0,0 +1,133 @@
+package vortex
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// ErrReservedClaim is returned by GenerateJWT and JWTBuilder for an extra
+// claim that would overwrite one the SDK sets itself, or a registered JWT
+// claim such as sub or exp
+var ErrReservedClaim = errors.New("vortex: reserved JWT claim")
+
+// reservedClaims are the payload claims GenerateJWTWithOptions sets from the
+// user and options, and the registered claims of RFC 7519
+var reservedClaims = map[string]bool{
+	"userId":      true,
+	"userEmail":   true,
+	"adminScopes": true,
+	"expires":     true,
+	"notBefore":   true,
+
+	"iss": true,
+	"sub": true,
+	"aud": true,
+	"exp": true,
+	"nbf": true,
+	"iat": true,
+	"jti": true,
+}
+
+// checkClaims fails with ErrReservedClaim if claims holds a reserved claim
+func checkClaims(claims map[string]interface{}) error {
+	for key := range claims {
+		if reservedClaims[key] {
+			return fmt.Errorf("%w %q", ErrReservedClaim, key)
+		}
+	}
+	return nil
+}
+
+// JWTBuilder assembles the claims of a JWT and validates them before signing
+//
+// Like GenerateJWT, it rejects reserved claims with ErrReservedClaim, and
+// also validates groups and the TTL. The first invalid call is reported by
+// Sign.
+//
+// Example:
+//
+//	jwt, err := vortex.NewJWTBuilder(user).
+//	    WithGroups(vortex.Group{Type: "workspace", GroupID: &workspaceID, Name: "Acme"}).
+//	    WithRole("admin").
+//	    WithTTL(15 * time.Minute).
+//	    WithClaim("department", "Engineering").
+//	    Sign(client)
+type JWTBuilder struct {
+	user   *User
+	claims map[string]interface{}
+	opts   JWTOptions
+	err    error
+}
+
+// NewJWTBuilder starts a JWT for user
+func NewJWTBuilder(user *User) *JWTBuilder {
+	return &JWTBuilder{user: user, claims: make(map[string]interface{})}
+}
+
+// WithGroups sets the groups the user belongs to
+func (b *JWTBuilder) WithGroups(groups ...Group) *JWTBuilder {
+	for i, group := range groups {
+		if group.Type == "" {
+			b.fail(fmt.Errorf("vortex: group %d has no type", i))
+		}
+		if group.GroupID == nil && group.ID == nil {
+			b.fail(fmt.Errorf("vortex: group %d has no GroupID", i))
+		}
+	}
+	b.claims["groups"] = groups
+	return b
+}
+
+// WithRole sets the user's role
+func (b *JWTBuilder) WithRole(role string) *JWTBuilder {
+	b.claims["role"] = role
+	return b
+}
+
+// WithTTL sets how long the token is valid for; the default is one hour
+func (b *JWTBuilder) WithTTL(ttl time.Duration) *JWTBuilder {
+	if ttl <= 0 {
+		b.fail(fmt.Errorf("vortex: JWT TTL must be positive, got %v", ttl))
+	}
+	b.opts.TTL = ttl
+	return b
+}
+
+// WithOptions sets the token's lifetime like GenerateJWTWithOptions; a TTL
+// set with WithTTL is kept unless opts has one
+func (b *JWTBuilder) WithOptions(opts JWTOptions) *JWTBuilder {
+	if opts.TTL == 0 {
+		opts.TTL = b.opts.TTL
+	}
+	b.opts = opts
+	return b
+}
+
+// WithClaim sets a custom claim, failing with ErrReservedClaim for one the
+// SDK sets itself
+func (b *JWTBuilder) WithClaim(key string, value interface{}) *JWTBuilder {
+	if err := checkClaims(map[string]interface{}{key: value}); err != nil {
+		b.fail(err)
+	}
+	b.claims[key] = value
+	return b
+}
+
+// Sign validates the claims and signs the token with client's API key
+func (b *JWTBuilder) Sign(client *Client) (string, error) {
+	if b.err != nil {
+		return "", b.err
+	}
+	if b.user == nil || b.user.ID == "" {
+		return "", errors.New("vortex: JWT user has no ID")
+	}
+	return client.GenerateJWTWithOptions(b.user, b.claims, b.opts)
+}
+
+// fail records err unless an earlier call already failed
+func (b *JWTBuilder) fail(err error) {
+	if b.err == nil {
+		b.err = err
+	}
+}
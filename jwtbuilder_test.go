@@ -0,0 +1,66 @@
+package vortex
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestJWTBuilder(t *testing.T) {
+	client := NewClient("VRTX.EjRWeBI0EjQSNBI0VniQEg.test-key")
+	workspaceID := "ws-1"
+
+	jwt, err := NewJWTBuilder(&User{ID: "user-123", Email: "test@example.com"}).
+		WithGroups(Group{Type: "workspace", GroupID: &workspaceID, Name: "Acme"}).
+		WithRole("admin").
+		WithTTL(15*time.Minute).
+		WithClaim("department", "Engineering").
+		Sign(client)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	claims, err := client.VerifyJWT(jwt)
+	if err != nil {
+		t.Fatalf("Expected a valid token, got %v", err)
+	}
+	if claims.UserID != "user-123" || claims.Role == nil || *claims.Role != "admin" {
+		t.Errorf("Unexpected claims %+v", claims)
+	}
+	if len(claims.Groups) != 1 || *claims.Groups[0].GroupID != "ws-1" {
+		t.Errorf("Expected workspace ws-1, got %+v", claims.Groups)
+	}
+	if ttl := time.Until(time.Unix(claims.Expires, 0)); ttl > 15*time.Minute || ttl < 14*time.Minute {
+		t.Errorf("Expected a 15 minute token, got %v", ttl)
+	}
+}
+
+func TestJWTBuilder_Invalid(t *testing.T) {
+	client := NewClient("VRTX.EjRWeBI0EjQSNBI0VniQEg.test-key")
+	user := &User{ID: "user-123"}
+
+	_, err := NewJWTBuilder(user).WithClaim("userId", "someone-else").Sign(client)
+	if !errors.Is(err, ErrReservedClaim) {
+		t.Errorf("Expected ErrReservedClaim, got %v", err)
+	}
+
+	if _, err := NewJWTBuilder(user).WithClaim("exp", 0).Sign(client); !errors.Is(err, ErrReservedClaim) {
+		t.Errorf("Expected ErrReservedClaim for exp, got %v", err)
+	}
+	for _, key := range []string{"userEmail", "sub", "iat"} {
+		_, err := client.GenerateJWT(user, map[string]interface{}{key: "x"})
+		if !errors.Is(err, ErrReservedClaim) {
+			t.Errorf("Expected ErrReservedClaim from GenerateJWT for %s, got %v", key, err)
+		}
+	}
+
+	if _, err := NewJWTBuilder(user).WithGroups(Group{Name: "No type"}).Sign(client); err == nil {
+		t.Error("Expected an error for a group without a type")
+	}
+	if _, err := NewJWTBuilder(user).WithTTL(-time.Minute).Sign(client); err == nil {
+		t.Error("Expected an error for a negative TTL")
+	}
+	if _, err := NewJWTBuilder(&User{}).Sign(client); err == nil {
+		t.Error("Expected an error for a user without an ID")
+	}
+}
@@ -0,0 +1,60 @@
+package vortex
+
+import (
+	"context"
+	"fmt"
+)
+
+// LandingPage configures the page invitees land on to accept an invitation
+type LandingPage struct {
+	LogoURL string `json:"logoUrl,omitempty"`
+	// Headline and Body are the page copy; both may use the same template
+	// variables as invitation emails, e.g. {{inviterName}}
+	Headline    string `json:"headline,omitempty"`
+	Body        string `json:"body,omitempty"`
+	ButtonLabel string `json:"buttonLabel,omitempty"`
+	// RedirectURL is where invitees are sent after accepting
+	RedirectURL string `json:"redirectUrl,omitempty"`
+}
+
+func landingPagePath(widgetConfigurationID string) string {
+	return fmt.Sprintf("/api/v1/widget-configurations/%s/landing-page", widgetConfigurationID)
+}
+
+// GetLandingPage retrieves the landing page for a widget configuration
+func (c *Client) GetLandingPage(ctx context.Context, widgetConfigurationID string, opts ...RequestOption) (*LandingPage, error) {
+	responseBody, err := c.apiRequestContext(ctx, "GET", landingPagePath(widgetConfigurationID), nil, nil, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	var page LandingPage
+	if err := c.decode(responseBody, &page); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	return &page, nil
+}
+
+// UpdateLandingPage replaces the landing page for a widget configuration
+//
+// Example:
+//
+//	page, err := client.UpdateLandingPage(ctx, widgetConfigurationID, vortex.LandingPage{
+//	    LogoURL:     "https://example.com/logo.svg",
+//	    Headline:    "{{inviterName}} invited you to Acme",
+//	    RedirectURL: "https://app.example.com/welcome",
+//	})
+func (c *Client) UpdateLandingPage(ctx context.Context, widgetConfigurationID string, page LandingPage, opts ...RequestOption) (*LandingPage, error) {
+	responseBody, err := c.apiRequestContext(ctx, "PUT", landingPagePath(widgetConfigurationID), page, nil, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	var updated LandingPage
+	if err := c.decode(responseBody, &updated); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	return &updated, nil
+}
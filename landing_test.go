@@ -0,0 +1,49 @@
+package vortex
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestLandingPage(t *testing.T) {
+	stored := LandingPage{Headline: "You're invited"}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v1/widget-configurations/wc-1/landing-page" {
+			t.Errorf("Expected path /api/v1/widget-configurations/wc-1/landing-page, got %s", r.URL.Path)
+		}
+		if r.Method == "PUT" {
+			stored = LandingPage{}
+			if err := json.NewDecoder(r.Body).Decode(&stored); err != nil {
+				t.Errorf("Failed to decode request body: %v", err)
+			}
+		}
+		json.NewEncoder(w).Encode(stored)
+	}))
+	defer server.Close()
+
+	client := NewClientWithOptions("test-api-key", server.URL, nil)
+	ctx := context.Background()
+
+	page, err := client.GetLandingPage(ctx, "wc-1")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if page.Headline != "You're invited" {
+		t.Errorf("Expected headline \"You're invited\", got %s", page.Headline)
+	}
+
+	page, err = client.UpdateLandingPage(ctx, "wc-1", LandingPage{
+		LogoURL:     "https://example.com/logo.svg",
+		RedirectURL: "https://app.example.com/welcome",
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if page.RedirectURL != "https://app.example.com/welcome" || page.Headline != "" {
+		t.Errorf("Expected the landing page to be replaced, got %+v", page)
+	}
+}
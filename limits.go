@@ -0,0 +1,53 @@
+package vortex
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// InvitationLimits are the project's invitation caps and current usage
+//
+// Bulk jobs can pace themselves against these instead of running into
+// 429 or 422 responses.
+type InvitationLimits struct {
+	// DailySends is the maximum number of invitations sent per day
+	DailySends int `json:"dailySends"`
+	// DailySendsUsed is the number sent so far today
+	DailySendsUsed int `json:"dailySendsUsed"`
+	// DailyResetAt is when the daily count resets
+	DailyResetAt time.Time `json:"dailyResetAt"`
+	// MaxPendingPerGroup is the maximum number of pending invitations a
+	// single group may have
+	MaxPendingPerGroup int `json:"maxPendingPerGroup"`
+}
+
+// DailySendsRemaining returns how many invitations can still be sent today
+func (l *InvitationLimits) DailySendsRemaining() int {
+	if remaining := l.DailySends - l.DailySendsUsed; remaining > 0 {
+		return remaining
+	}
+	return 0
+}
+
+// GetInvitationLimits retrieves the project's invitation caps and usage
+//
+// Example:
+//
+//	limits, err := client.GetInvitationLimits(ctx)
+//	if remaining := limits.DailySendsRemaining(); len(batch) > remaining {
+//	    batch = batch[:remaining]
+//	}
+func (c *Client) GetInvitationLimits(ctx context.Context, opts ...RequestOption) (*InvitationLimits, error) {
+	responseBody, err := c.apiRequestContext(ctx, "GET", "/api/v1/invitations/limits", nil, nil, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	var limits InvitationLimits
+	if err := c.decode(responseBody, &limits); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	return &limits, nil
+}
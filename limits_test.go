@@ -0,0 +1,41 @@
+package vortex
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestGetInvitationLimits(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "GET" || r.URL.Path != "/api/v1/invitations/limits" {
+			t.Errorf("Expected GET /api/v1/invitations/limits, got %s %s", r.Method, r.URL.Path)
+		}
+		w.Write([]byte(`{
+			"dailySends": 1000,
+			"dailySendsUsed": 990,
+			"dailyResetAt": "2024-01-02T00:00:00Z",
+			"maxPendingPerGroup": 50
+		}`))
+	}))
+	defer server.Close()
+
+	client := NewClientWithOptions("test-api-key", server.URL, nil)
+
+	limits, err := client.GetInvitationLimits(context.Background())
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if limits.MaxPendingPerGroup != 50 {
+		t.Errorf("Expected max pending per group 50, got %d", limits.MaxPendingPerGroup)
+	}
+	if limits.DailySendsRemaining() != 10 {
+		t.Errorf("Expected 10 daily sends remaining, got %d", limits.DailySendsRemaining())
+	}
+
+	limits.DailySendsUsed = 1200
+	if limits.DailySendsRemaining() != 0 {
+		t.Errorf("Expected 0 daily sends remaining, got %d", limits.DailySendsRemaining())
+	}
+}
@@ -0,0 +1,97 @@
+package vortex
+
+import (
+	"context"
+	"fmt"
+)
+
+// Shortener shortens invitation links before they are handed out
+//
+// Implementations must redirect to the full link unchanged, since its query
+// parameters carry the click attribution.
+type Shortener interface {
+	Shorten(ctx context.Context, longURL string) (string, error)
+}
+
+// ShortenerFunc adapts a function to the Shortener interface
+type ShortenerFunc func(ctx context.Context, longURL string) (string, error)
+
+// Shorten implements Shortener
+func (f ShortenerFunc) Shorten(ctx context.Context, longURL string) (string, error) {
+	return f(ctx, longURL)
+}
+
+// WithShortener passes links returned by GetInvitationLink through shortener
+//
+// Example:
+//
+//	client := vortex.NewClient(apiKey, vortex.WithShortener(vortex.ShortenerFunc(bitly.Shorten)))
+func WithShortener(shortener Shortener) ClientOption {
+	return func(c *Client) {
+		c.shortener = shortener
+	}
+}
+
+// WithVortexShortener passes links returned by GetInvitationLink through the
+// Vortex link shortener
+func WithVortexShortener() ClientOption {
+	return func(c *Client) {
+		c.shortener = ShortenerFunc(func(ctx context.Context, longURL string) (string, error) {
+			return c.ShortenLink(ctx, longURL)
+		})
+	}
+}
+
+// ShortenLink shortens a URL with the Vortex link shortener
+//
+// Clicks on the short link are attributed to the invitation the full link
+// belongs to.
+func (c *Client) ShortenLink(ctx context.Context, longURL string, opts ...RequestOption) (string, error) {
+	requestBody := map[string]string{
+		"url": longURL,
+	}
+
+	responseBody, err := c.apiRequestContext(ctx, "POST", "/api/v1/links/shorten", requestBody, nil, opts...)
+	if err != nil {
+		return "", err
+	}
+
+	var response struct {
+		ShortURL string `json:"shortUrl"`
+	}
+	if err := c.decode(responseBody, &response); err != nil {
+		return "", fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	return response.ShortURL, nil
+}
+
+// GetInvitationLink returns the accept link for an invitation, for delivering
+// it through your own channels
+//
+// The link is shortened when the client has a shortener configured.
+func (c *Client) GetInvitationLink(ctx context.Context, invitationID string, opts ...RequestOption) (string, error) {
+	path := fmt.Sprintf("/api/v1/invitations/%s/link", invitationID)
+
+	responseBody, err := c.apiRequestContext(ctx, "GET", path, nil, nil, opts...)
+	if err != nil {
+		return "", err
+	}
+
+	var response struct {
+		URL string `json:"url"`
+	}
+	if err := c.decode(responseBody, &response); err != nil {
+		return "", fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	if c.shortener == nil {
+		return response.URL, nil
+	}
+
+	shortURL, err := c.shortener.Shorten(ctx, response.URL)
+	if err != nil {
+		return "", fmt.Errorf("failed to shorten invitation link: %w", err)
+	}
+	return shortURL, nil
+}
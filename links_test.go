@@ -0,0 +1,85 @@
+package vortex
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+const testInvitationLink = "https://invite.example.com/accept?id=inv1&ref=email"
+
+func linkServer(t *testing.T) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v1/invitations/inv1/link":
+			w.Write([]byte(`{"url": "` + testInvitationLink + `"}`))
+		case "/api/v1/links/shorten":
+			var req map[string]string
+			if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+				t.Errorf("Failed to decode request body: %v", err)
+			}
+			if req["url"] != testInvitationLink {
+				t.Errorf("Expected the full link to be shortened, got %s", req["url"])
+			}
+			w.Write([]byte(`{"shortUrl": "https://vrtx.link/abc"}`))
+		default:
+			t.Errorf("Unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	}))
+}
+
+func TestGetInvitationLink(t *testing.T) {
+	server := linkServer(t)
+	defer server.Close()
+	ctx := context.Background()
+
+	client := NewClientWithOptions("test-api-key", server.URL, nil)
+	link, err := client.GetInvitationLink(ctx, "inv1")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if link != testInvitationLink {
+		t.Errorf("Expected the full link, got %s", link)
+	}
+
+	client = NewClientWithOptions("test-api-key", server.URL, nil, WithVortexShortener())
+	link, err = client.GetInvitationLink(ctx, "inv1")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if link != "https://vrtx.link/abc" {
+		t.Errorf("Expected the Vortex short link, got %s", link)
+	}
+}
+
+func TestWithShortener(t *testing.T) {
+	server := linkServer(t)
+	defer server.Close()
+
+	shortener := ShortenerFunc(func(ctx context.Context, longURL string) (string, error) {
+		if longURL != testInvitationLink {
+			t.Errorf("Expected the full link, got %s", longURL)
+		}
+		return "https://sho.rt/1", nil
+	})
+	client := NewClientWithOptions("test-api-key", server.URL, nil, WithShortener(shortener))
+
+	link, err := client.GetInvitationLink(context.Background(), "inv1")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if link != "https://sho.rt/1" {
+		t.Errorf("Expected the custom short link, got %s", link)
+	}
+
+	failing := ShortenerFunc(func(ctx context.Context, longURL string) (string, error) {
+		return "", errors.New("quota exceeded")
+	})
+	client = NewClientWithOptions("test-api-key", server.URL, nil, WithShortener(failing))
+	if _, err := client.GetInvitationLink(context.Background(), "inv1"); err == nil {
+		t.Error("Expected the shortener error to be returned")
+	}
+}
@@ -0,0 +1,21 @@
+package vortex
+
+// WithLocale sets the Accept-Language sent with every request, so emails,
+// landing pages and errors come back in that language where the account has
+// translations
+//
+// Example:
+//
+//	client := vortex.NewClient(apiKey, vortex.WithLocale("fr-CA"))
+func WithLocale(locale string) ClientOption {
+	return func(c *Client) {
+		c.locale = locale
+	}
+}
+
+// Locale overrides the client's locale for a single call
+func Locale(locale string) RequestOption {
+	return func(o *requestOptions) {
+		o.header.Set("Accept-Language", locale)
+	}
+}
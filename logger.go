@@ -0,0 +1,23 @@
+package vortex
+
+// Logger receives the client's log output
+//
+// Messages come with alternating key/value pairs, as in zap's SugaredLogger.
+// Adapters for zap and logrus live in the vortexzap and vortexlogrus modules.
+type Logger interface {
+	Debug(msg string, keysAndValues ...interface{})
+	Info(msg string, keysAndValues ...interface{})
+	Warn(msg string, keysAndValues ...interface{})
+	Error(msg string, keysAndValues ...interface{})
+}
+
+// WithLogger logs API requests at debug level and failed requests at error level
+//
+// Example:
+//
+//	client := vortex.NewClient(apiKey, vortex.WithLogger(vortexzap.New(zapLogger)))
+func WithLogger(logger Logger) ClientOption {
+	return func(c *Client) {
+		c.logger = logger
+	}
+}
@@ -0,0 +1,51 @@
+package vortex
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+type recordingLogger struct {
+	lines []string
+}
+
+func (l *recordingLogger) log(level, msg string, keysAndValues ...interface{}) {
+	l.lines = append(l.lines, fmt.Sprint(level, " ", msg, keysAndValues[:4]))
+}
+
+func (l *recordingLogger) Debug(msg string, kv ...interface{}) { l.log("debug", msg, kv...) }
+func (l *recordingLogger) Info(msg string, kv ...interface{})  { l.log("info", msg, kv...) }
+func (l *recordingLogger) Warn(msg string, kv ...interface{})  { l.log("warn", msg, kv...) }
+func (l *recordingLogger) Error(msg string, kv ...interface{}) { l.log("error", msg, kv...) }
+
+func TestWithLogger(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "DELETE" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Write([]byte(`{"id":"inv-1"}`))
+	}))
+	defer server.Close()
+
+	logger := &recordingLogger{}
+	client := NewClientWithOptions("test-api-key", server.URL, nil, WithLogger(logger))
+
+	client.GetInvitation("inv-1")
+	client.RevokeInvitation("inv-1")
+
+	expected := []string{
+		"debug vortex request[method GET path /api/v1/invitations/inv-1]",
+		"error vortex request failed[method DELETE path /api/v1/invitations/inv-1]",
+	}
+	if len(logger.lines) != len(expected) {
+		t.Fatalf("Expected %d log lines, got %v", len(expected), logger.lines)
+	}
+	for i := range expected {
+		if logger.lines[i] != expected[i] {
+			t.Errorf("Expected %q, got %q", expected[i], logger.lines[i])
+		}
+	}
+}
@@ -0,0 +1,128 @@
+package vortex
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// Member is a user belonging to a group
+type Member struct {
+	UserID string `json:"userId"`
+	Email  string `json:"email,omitempty"`
+	Name   string `json:"name,omitempty"`
+	// Role is the member's role in the group, e.g. "admin"
+	Role     string     `json:"role,omitempty"`
+	JoinedAt *time.Time `json:"joinedAt,omitempty"`
+}
+
+// MembersResponse is a page of group members
+type MembersResponse struct {
+	Members    []Member `json:"members"`
+	NextCursor string   `json:"nextCursor,omitempty"`
+}
+
+// MemberPageFunc fetches the page of members starting at cursor, like
+// InvitationPageFunc
+type MemberPageFunc func(ctx context.Context, cursor string) (members []Member, nextCursor string, err error)
+
+// MemberIterator steps through group members a page at a time
+//
+//	it := client.ListGroupMembers(ctx, "team", "team-1")
+//	for it.Next() {
+//	    member := it.Member()
+//	    // ...
+//	}
+//	if err := it.Err(); err != nil {
+//	    return err
+//	}
+type MemberIterator struct {
+	pager
+	page []Member
+}
+
+// NewMemberIterator creates an iterator over the pages fetch returns, e.g. to
+// return canned pages from a mock
+func NewMemberIterator(ctx context.Context, fetch MemberPageFunc) *MemberIterator {
+	it := &MemberIterator{}
+	it.pager = pager{ctx: ctx, fetch: func(ctx context.Context, cursor string) (int, string, error) {
+		page, next, err := fetch(ctx, cursor)
+		if err != nil {
+			return 0, "", err
+		}
+		it.page = page
+		return len(page), next, nil
+	}}
+	return it
+}
+
+// Next advances to the next member, fetching the next page when needed, and
+// reports whether there is one
+func (it *MemberIterator) Next() bool {
+	return it.next()
+}
+
+// Member returns the current member; it is only valid after Next returned true
+func (it *MemberIterator) Member() Member {
+	return it.page[it.index]
+}
+
+// Err returns the error that stopped the iteration, if any
+func (it *MemberIterator) Err() error {
+	return it.err
+}
+
+// AddGroupMembers adds users to a group directly, without sending
+// invitations, e.g. to sync an internal org structure to Vortex
+//
+// Members who already belong to the group are updated.
+//
+// Example:
+//
+//	err := client.AddGroupMembers(ctx, "team", "team-1", []vortex.Member{
+//	    {UserID: "user-1", Email: "ada@example.com", Role: "admin"},
+//	    {UserID: "user-2", Email: "alan@example.com"},
+//	})
+func (c *Client) AddGroupMembers(ctx context.Context, groupType, groupID string, members []Member, opts ...RequestOption) error {
+	path := fmt.Sprintf("/api/v1/groups/%s/%s/members", groupType, groupID)
+	requestBody := map[string]interface{}{
+		"members": members,
+	}
+
+	_, err := c.apiRequestContext(ctx, "POST", path, requestBody, nil, opts...)
+	return err
+}
+
+// RemoveGroupMember removes a user from a group
+func (c *Client) RemoveGroupMember(ctx context.Context, groupType, groupID, userID string, opts ...RequestOption) error {
+	path := fmt.Sprintf("/api/v1/groups/%s/%s/members/%s", groupType, groupID, userID)
+
+	_, err := c.apiRequestContext(ctx, "DELETE", path, nil, nil, opts...)
+	return err
+}
+
+// ListGroupMembers lists a group's members DefaultPageSize at a time
+func (c *Client) ListGroupMembers(ctx context.Context, groupType, groupID string, opts ...RequestOption) *MemberIterator {
+	path := fmt.Sprintf("/api/v1/groups/%s/%s/members", groupType, groupID)
+
+	return NewMemberIterator(ctx, func(ctx context.Context, cursor string) ([]Member, string, error) {
+		queryParams := map[string]string{
+			"limit": strconv.Itoa(DefaultPageSize),
+		}
+		if cursor != "" {
+			queryParams["cursor"] = cursor
+		}
+
+		responseBody, err := c.apiRequestContext(ctx, "GET", path, nil, queryParams, opts...)
+		if err != nil {
+			return nil, "", err
+		}
+
+		var response MembersResponse
+		if err := c.decode(responseBody, &response); err != nil {
+			return nil, "", fmt.Errorf("failed to unmarshal response: %w", err)
+		}
+		return response.Members, response.NextCursor, nil
+	})
+}
@@ -0,0 +1,90 @@
+package vortex
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestAddGroupMembers(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "POST" {
+			t.Errorf("Expected POST, got %s", r.Method)
+		}
+		if r.URL.Path != "/api/v1/groups/team/team-1/members" {
+			t.Errorf("Expected /api/v1/groups/team/team-1/members, got %s", r.URL.Path)
+		}
+
+		var body struct {
+			Members []Member `json:"members"`
+		}
+		json.NewDecoder(r.Body).Decode(&body)
+		if len(body.Members) != 2 || body.Members[0].UserID != "user-1" || body.Members[0].Role != "admin" {
+			t.Errorf("Unexpected members %+v", body.Members)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	client := NewClientWithOptions("test-api-key", server.URL, nil)
+
+	err := client.AddGroupMembers(context.Background(), "team", "team-1", []Member{
+		{UserID: "user-1", Email: "ada@example.com", Role: "admin"},
+		{UserID: "user-2"},
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+}
+
+func TestRemoveGroupMember(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "DELETE" {
+			t.Errorf("Expected DELETE, got %s", r.Method)
+		}
+		if r.URL.Path != "/api/v1/groups/team/team-1/members/user-1" {
+			t.Errorf("Expected /api/v1/groups/team/team-1/members/user-1, got %s", r.URL.Path)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	client := NewClientWithOptions("test-api-key", server.URL, nil)
+
+	if err := client.RemoveGroupMember(context.Background(), "team", "team-1", "user-1"); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+}
+
+func TestListGroupMembers(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v1/groups/team/team-1/members" {
+			t.Errorf("Expected /api/v1/groups/team/team-1/members, got %s", r.URL.Path)
+		}
+		switch r.URL.Query().Get("cursor") {
+		case "":
+			w.Write([]byte(`{"members": [{"userId": "user-1", "joinedAt": "2024-01-01T00:00:00Z"}, {"userId": "user-2"}], "nextCursor": "c2"}`))
+		case "c2":
+			w.Write([]byte(`{"members": [{"userId": "user-3"}]}`))
+		default:
+			t.Errorf("Unexpected cursor %s", r.URL.Query().Get("cursor"))
+		}
+	}))
+	defer server.Close()
+
+	client := NewClientWithOptions("test-api-key", server.URL, nil)
+
+	var userIDs []string
+	it := client.ListGroupMembers(context.Background(), "team", "team-1")
+	for it.Next() {
+		userIDs = append(userIDs, it.Member().UserID)
+	}
+	if err := it.Err(); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if len(userIDs) != 3 || userIDs[0] != "user-1" || userIDs[2] != "user-3" {
+		t.Errorf("Expected user-1, user-2 and user-3, got %v", userIDs)
+	}
+}
@@ -0,0 +1,113 @@
+package vortex
+
+import (
+	"net/http"
+	"strings"
+	"time"
+)
+
+// MetricsCollector receives a measurement of every request the client sends,
+// e.g. to export request counts, latencies and error rates
+//
+// Endpoint is the request path with IDs replaced by "{id}", such as
+// "/api/v1/invitations/{id}/reinvite", so it is safe to use as a metric
+// label. Each attempt of a retried call is measured separately. Methods are
+// called concurrently.
+type MetricsCollector interface {
+	// RequestStarted is called before a request is sent
+	RequestStarted(method, endpoint string)
+	// RequestFinished is called once the response headers arrive; status is
+	// zero when no response was received, e.g. on a timeout
+	RequestFinished(method, endpoint string, status int, duration time.Duration)
+}
+
+// WithMetrics reports every request to collector; the vortexprometheus
+// module provides a Prometheus collector
+func WithMetrics(collector MetricsCollector) ClientOption {
+	return func(c *Client) {
+		c.metrics = collector
+	}
+}
+
+// measure is a Middleware reporting each request to collector
+func measure(collector MetricsCollector) Middleware {
+	return func(next RoundTripFunc) RoundTripFunc {
+		return func(req *http.Request) (*http.Response, error) {
+			endpoint := endpointPattern(req.URL.Path)
+			collector.RequestStarted(req.Method, endpoint)
+
+			start := time.Now()
+			resp, err := next(req)
+			status := 0
+			if err == nil {
+				status = resp.StatusCode
+			}
+			collector.RequestFinished(req.Method, endpoint, status, time.Since(start))
+			return resp, err
+		}
+	}
+}
+
+// endpointPattern replaces the IDs in an API path with "{id}"
+func endpointPattern(path string) string {
+	segments := strings.Split(path, "/")
+	for i, segment := range segments {
+		if segment != "" && !pathSegments[segment] {
+			segments[i] = "{id}"
+		}
+	}
+	return strings.Join(segments, "/")
+}
+
+// pathSegments are the fixed segments of the API's paths
+var pathSegments = map[string]bool{
+	"api":                   true,
+	"v1":                    true,
+	"accept":                true,
+	"analytics":             true,
+	"approve":               true,
+	"archive":               true,
+	"attachments":           true,
+	"bootstrap":             true,
+	"by-group":              true,
+	"clone":                 true,
+	"deliveries":            true,
+	"email-templates":       true,
+	"erasure":               true,
+	"events":                true,
+	"export":                true,
+	"graphql":               true,
+	"groups":                true,
+	"invitations":           true,
+	"invite-links":          true,
+	"landing-page":          true,
+	"limits":                true,
+	"link":                  true,
+	"links":                 true,
+	"localizations":         true,
+	"members":               true,
+	"move":                  true,
+	"policy":                true,
+	"preview":               true,
+	"privacy":               true,
+	"referral":              true,
+	"reinvite":              true,
+	"reject":                true,
+	"reminders":             true,
+	"restore":               true,
+	"retry":                 true,
+	"revoke-by-target":      true,
+	"rotate":                true,
+	"seats":                 true,
+	"settings":              true,
+	"shorten":               true,
+	"sms":                   true,
+	"stats":                 true,
+	"suppressions":          true,
+	"tags":                  true,
+	"throttle":              true,
+	"timeseries":            true,
+	"variant-metrics":       true,
+	"widget":                true,
+	"widget-configurations": true,
+}
@@ -0,0 +1,96 @@
+package vortex
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+)
+
+type recordedRequest struct {
+	method, endpoint string
+	status           int
+}
+
+type testCollector struct {
+	mu       sync.Mutex
+	inFlight int
+	finished []recordedRequest
+}
+
+func (c *testCollector) RequestStarted(method, endpoint string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.inFlight++
+}
+
+func (c *testCollector) RequestFinished(method, endpoint string, status int, duration time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.inFlight--
+	c.finished = append(c.finished, recordedRequest{method, endpoint, status})
+}
+
+func TestWithMetrics(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "POST" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Write([]byte(`{"id": "inv-1"}`))
+	}))
+	defer server.Close()
+
+	collector := &testCollector{}
+	client := NewClientWithOptions("test-api-key", server.URL, nil, WithMetrics(collector))
+
+	client.GetInvitation("inv-1")
+	client.Reinvite("inv-2")
+
+	want := []recordedRequest{
+		{"GET", "/api/v1/invitations/{id}", 200},
+		{"POST", "/api/v1/invitations/{id}/reinvite", 404},
+	}
+	if len(collector.finished) != len(want) {
+		t.Fatalf("Expected %d requests, got %+v", len(want), collector.finished)
+	}
+	for i := range want {
+		if collector.finished[i] != want[i] {
+			t.Errorf("Expected %+v, got %+v", want[i], collector.finished[i])
+		}
+	}
+	if collector.inFlight != 0 {
+		t.Errorf("Expected no requests in flight, got %d", collector.inFlight)
+	}
+}
+
+func TestWithMetrics_NetworkError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	server.Close()
+
+	collector := &testCollector{}
+	client := NewClientWithOptions("test-api-key", server.URL, nil, WithMetrics(collector))
+
+	if _, err := client.GetInvitation("inv-1"); err == nil {
+		t.Fatal("Expected an error from the closed server")
+	}
+	if len(collector.finished) != 1 || collector.finished[0].status != 0 {
+		t.Errorf("Expected one request with status 0, got %+v", collector.finished)
+	}
+}
+
+func TestEndpointPattern(t *testing.T) {
+	tests := map[string]string{
+		"/api/v1/invitations":                           "/api/v1/invitations",
+		"/api/v1/invitations/inv-1":                     "/api/v1/invitations/{id}",
+		"/api/v1/invitations/by-group/team/t-1":         "/api/v1/invitations/by-group/{id}/{id}",
+		"/api/v1/invitations/inv-1/deliveries/d2/retry": "/api/v1/invitations/{id}/deliveries/{id}/retry",
+		"/api/v1/groups/team/t-1/seats":                 "/api/v1/groups/{id}/{id}/seats",
+	}
+	for path, want := range tests {
+		if got := endpointPattern(path); got != want {
+			t.Errorf("%s: Expected %s, got %s", path, want, got)
+		}
+	}
+}
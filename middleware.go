@@ -0,0 +1,58 @@
+package vortex
+
+import "net/http"
+
+// RoundTripFunc sends one HTTP request and returns its response, like
+// http.RoundTripper
+type RoundTripFunc func(req *http.Request) (*http.Response, error)
+
+// Middleware wraps the sending of each request, e.g. for logging, metrics,
+// rewriting headers or injecting failures in tests
+//
+// It runs once per attempt, after the request is built and before it reaches
+// the HTTP client, so it sees the final headers and every retry.
+type Middleware func(next RoundTripFunc) RoundTripFunc
+
+// WithMiddleware adds middleware to every request, see Use
+func WithMiddleware(middleware ...Middleware) ClientOption {
+	return func(c *Client) {
+		c.Use(middleware...)
+	}
+}
+
+// Use adds middleware to every request the client sends; the first middleware
+// added is the outermost. Call it before the client is shared between
+// goroutines.
+//
+// Example:
+//
+//	client.Use(func(next vortex.RoundTripFunc) vortex.RoundTripFunc {
+//	    return func(req *http.Request) (*http.Response, error) {
+//	        start := time.Now()
+//	        resp, err := next(req)
+//	        metrics.ObserveVortexRequest(req.Method, req.URL.Path, time.Since(start))
+//	        return resp, err
+//	    }
+//	})
+func (c *Client) Use(middleware ...Middleware) {
+	// Copy so clients returned by AsUser do not share additions
+	chain := make([]Middleware, 0, len(c.middleware)+len(middleware))
+	chain = append(chain, c.middleware...)
+	c.middleware = append(chain, middleware...)
+}
+
+// roundTrip sends req through the middleware to the HTTP client
+func (c *Client) roundTrip(req *http.Request) (*http.Response, error) {
+	next := RoundTripFunc(c.httpClient.Do)
+	if c.debug != nil {
+		// Innermost, so the dump shows what middleware actually sent
+		next = c.debug.wrap(next)
+	}
+	if c.metrics != nil {
+		next = measure(c.metrics)(next)
+	}
+	for i := len(c.middleware) - 1; i >= 0; i-- {
+		next = c.middleware[i](next)
+	}
+	return next(req)
+}
@@ -0,0 +1,113 @@
+package vortex
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestUse(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Tenant") != "acme" {
+			t.Errorf("Expected X-Tenant acme, got %q", r.Header.Get("X-Tenant"))
+		}
+		w.Write([]byte(`{"id": "inv-1"}`))
+	}))
+	defer server.Close()
+
+	var order []string
+	trace := func(name string) Middleware {
+		return func(next RoundTripFunc) RoundTripFunc {
+			return func(req *http.Request) (*http.Response, error) {
+				order = append(order, name+" before")
+				resp, err := next(req)
+				order = append(order, name+" after")
+				return resp, err
+			}
+		}
+	}
+	setTenant := func(next RoundTripFunc) RoundTripFunc {
+		return func(req *http.Request) (*http.Response, error) {
+			req.Header.Set("X-Tenant", "acme")
+			return next(req)
+		}
+	}
+
+	client := NewClientWithOptions("test-api-key", server.URL, nil, WithMiddleware(trace("outer")))
+	client.Use(trace("inner"), setTenant)
+
+	if _, err := client.GetInvitation("inv-1"); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	want := "outer before,inner before,inner after,outer after"
+	if got := strings.Join(order, ","); got != want {
+		t.Errorf("Expected order %s, got %s", want, got)
+	}
+}
+
+func TestUse_FailureInjection(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Write([]byte(`{"id": "inv-1"}`))
+	}))
+	defer server.Close()
+
+	errChaos := errors.New("chaos")
+	failures := 0
+	client := NewClientWithOptions("test-api-key", server.URL, nil, WithRetry(3, time.Millisecond))
+	client.Use(func(next RoundTripFunc) RoundTripFunc {
+		return func(req *http.Request) (*http.Response, error) {
+			if failures < 1 {
+				// Connection failures reach the client as *url.Error
+				failures++
+				return nil, &url.Error{Op: req.Method, URL: req.URL.String(), Err: errChaos}
+			}
+			if failures < 2 {
+				failures++
+				return &http.Response{
+					StatusCode: http.StatusServiceUnavailable,
+					Status:     "503 Service Unavailable",
+					Header:     http.Header{},
+					Body:       io.NopCloser(strings.NewReader("")),
+				}, nil
+			}
+			return next(req)
+		}
+	})
+
+	if _, err := client.GetInvitationContext(context.Background(), "inv-1"); err != nil {
+		t.Fatalf("Expected no error after retries, got %v", err)
+	}
+	if requests != 1 {
+		t.Errorf("Expected 1 request to reach the server, got %d", requests)
+	}
+
+	failures = 0
+	client = NewClientWithOptions("test-api-key", server.URL, nil)
+	client.Use(func(next RoundTripFunc) RoundTripFunc {
+		return func(req *http.Request) (*http.Response, error) {
+			return nil, errChaos
+		}
+	})
+	if _, err := client.GetInvitation("inv-1"); !errors.Is(err, errChaos) {
+		t.Errorf("Expected chaos error, got %v", err)
+	}
+}
+
+func TestUse_AsUser(t *testing.T) {
+	client := NewClient("test-api-key")
+	userClient := client.AsUser(&User{ID: "user-1"})
+	userClient.Use(func(next RoundTripFunc) RoundTripFunc { return next })
+
+	if len(client.middleware) != 0 {
+		t.Errorf("Expected parent client to have no middleware, got %d", len(client.middleware))
+	}
+}
@@ -0,0 +1,105 @@
+package vortex
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// NodeConfig mirrors the configuration used to construct the Vortex Node SDK
+// (`new Vortex(apiKey)` plus the VORTEX_API_BASE_URL override)
+type NodeConfig struct {
+	APIKey  string `json:"apiKey"`
+	BaseURL string `json:"baseUrl,omitempty"`
+}
+
+// NewClient creates a Go client equivalent to the Node SDK configuration
+func (c *NodeConfig) NewClient() *Client {
+	if c.BaseURL == "" {
+		return NewClient(c.APIKey)
+	}
+	return NewClientWithOptions(c.APIKey, c.BaseURL, nil)
+}
+
+// ParseNodeConfig reads a Node SDK configuration object from JSON
+func ParseNodeConfig(data []byte) (*NodeConfig, error) {
+	var config NodeConfig
+	if err := json.Unmarshal(data, &config); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal Node SDK config: %w", err)
+	}
+	if config.APIKey == "" {
+		return nil, fmt.Errorf("Node SDK config is missing apiKey")
+	}
+	return &config, nil
+}
+
+// ParseNodeJWTParams converts the params object passed to the Node SDK's
+// generateJwt into the arguments expected by GenerateJWT
+//
+// The current Node shape is supported:
+//
+//	{"user": {"id": "...", "email": "...", "adminScopes": ["autojoin"]}, "role": "admin"}
+//
+// as well as the older flat shape:
+//
+//	{"userId": "...", "userEmail": "...", "userIsAutojoinAdmin": true}
+//
+// Any properties besides the user are returned as extra JWT properties.
+func ParseNodeJWTParams(data []byte) (*User, map[string]interface{}, error) {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return nil, nil, fmt.Errorf("failed to unmarshal Node SDK JWT params: %w", err)
+	}
+
+	user := &User{}
+	if userJSON, ok := raw["user"]; ok {
+		if err := json.Unmarshal(userJSON, user); err != nil {
+			return nil, nil, fmt.Errorf("failed to unmarshal Node SDK user: %w", err)
+		}
+		delete(raw, "user")
+	} else {
+		var legacy JWTPayloadSimple
+		if err := json.Unmarshal(data, &legacy); err != nil {
+			return nil, nil, fmt.Errorf("failed to unmarshal Node SDK JWT params: %w", err)
+		}
+		user.ID = legacy.UserID
+		user.Email = legacy.UserEmail
+		if legacy.UserIsAutojoinAdmin != nil && *legacy.UserIsAutojoinAdmin {
+			user.AdminScopes = []string{"autojoin"}
+		}
+		delete(raw, "userId")
+		delete(raw, "userEmail")
+		delete(raw, "userIsAutojoinAdmin")
+	}
+
+	if user.ID == "" {
+		return nil, nil, fmt.Errorf("Node SDK JWT params are missing a user ID")
+	}
+
+	var extra map[string]interface{}
+	for key, value := range raw {
+		var v interface{}
+		if err := json.Unmarshal(value, &v); err != nil {
+			return nil, nil, fmt.Errorf("failed to unmarshal Node SDK property %q: %w", key, err)
+		}
+		if extra == nil {
+			extra = make(map[string]interface{})
+		}
+		extra[key] = v
+	}
+
+	return user, extra, nil
+}
+
+// ParseNodeAcceptInvitations converts the arguments of the Node SDK's
+// acceptInvitations call, expressed as {"invitationIds": [...], "target": {...}},
+// into the arguments expected by AcceptInvitations
+func ParseNodeAcceptInvitations(data []byte) ([]string, InvitationTarget, error) {
+	var request AcceptInvitationRequest
+	if err := json.Unmarshal(data, &request); err != nil {
+		return nil, InvitationTarget{}, fmt.Errorf("failed to unmarshal Node SDK accept params: %w", err)
+	}
+	if len(request.InvitationIDs) == 0 {
+		return nil, InvitationTarget{}, fmt.Errorf("Node SDK accept params are missing invitationIds")
+	}
+	return request.InvitationIDs, request.Target, nil
+}
@@ -0,0 +1,98 @@
+package vortex
+
+import (
+	"testing"
+)
+
+func TestParseNodeConfig(t *testing.T) {
+	config, err := ParseNodeConfig([]byte(`{"apiKey": "test-api-key", "baseUrl": "https://custom.example.com"}`))
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	client := config.NewClient()
+	if client.apiKey != "test-api-key" {
+		t.Errorf("Expected apiKey to be 'test-api-key', got %s", client.apiKey)
+	}
+	if client.baseURL != "https://custom.example.com" {
+		t.Errorf("Expected baseURL to be 'https://custom.example.com', got %s", client.baseURL)
+	}
+
+	t.Setenv("VORTEX_API_BASE_URL", "https://env.example.com")
+	config, err = ParseNodeConfig([]byte(`{"apiKey": "test-api-key"}`))
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if baseURL := config.NewClient().baseURL; baseURL != "https://env.example.com" {
+		t.Errorf("Expected baseURL from VORTEX_API_BASE_URL, got %s", baseURL)
+	}
+
+	if _, err := ParseNodeConfig([]byte(`{}`)); err == nil {
+		t.Error("Expected error for config without apiKey")
+	}
+}
+
+func TestParseNodeJWTParams(t *testing.T) {
+	user, extra, err := ParseNodeJWTParams([]byte(`{
+		"user": {"id": "user-123", "email": "test@example.com", "adminScopes": ["autojoin"]},
+		"role": "admin"
+	}`))
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if user.ID != "user-123" || user.Email != "test@example.com" {
+		t.Errorf("Unexpected user: %+v", user)
+	}
+	if len(user.AdminScopes) != 1 || user.AdminScopes[0] != "autojoin" {
+		t.Errorf("Expected adminScopes to be [autojoin], got %v", user.AdminScopes)
+	}
+	if extra["role"] != "admin" {
+		t.Errorf("Expected extra role to be 'admin', got %v", extra["role"])
+	}
+	if _, ok := extra["user"]; ok {
+		t.Error("Expected user to be removed from extra properties")
+	}
+}
+
+func TestParseNodeJWTParams_Legacy(t *testing.T) {
+	user, extra, err := ParseNodeJWTParams([]byte(`{
+		"userId": "user-123",
+		"userEmail": "test@example.com",
+		"userIsAutojoinAdmin": true
+	}`))
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if user.ID != "user-123" || user.Email != "test@example.com" {
+		t.Errorf("Unexpected user: %+v", user)
+	}
+	if len(user.AdminScopes) != 1 || user.AdminScopes[0] != "autojoin" {
+		t.Errorf("Expected adminScopes to be [autojoin], got %v", user.AdminScopes)
+	}
+	if extra != nil {
+		t.Errorf("Expected no extra properties, got %v", extra)
+	}
+
+	if _, _, err := ParseNodeJWTParams([]byte(`{"user": {"email": "test@example.com"}}`)); err == nil {
+		t.Error("Expected error for params without a user ID")
+	}
+}
+
+func TestParseNodeAcceptInvitations(t *testing.T) {
+	ids, target, err := ParseNodeAcceptInvitations([]byte(`{
+		"invitationIds": ["inv1", "inv2"],
+		"target": {"type": "email", "value": "test@example.com"}
+	}`))
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if len(ids) != 2 {
+		t.Errorf("Expected 2 invitation IDs, got %d", len(ids))
+	}
+	if target.Type != "email" || target.Value != "test@example.com" {
+		t.Errorf("Unexpected target: %+v", target)
+	}
+}
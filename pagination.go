@@ -0,0 +1,268 @@
+package vortex
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// DefaultPageSize is the number of invitations ListInvitations fetches per
+// request when ListOptions.PageSize is not set
+const DefaultPageSize = 100
+
+// ListOptions selects the invitations returned by ListInvitations
+//
+// Set TargetType and TargetValue to list a target's invitations, or GroupType
+// and GroupID to list a group's; with neither, every invitation is listed.
+// TargetType alone lists every invitation sent over that kind of target. The
+// remaining fields narrow the list further and are ignored when unset.
+//
+// Example:
+//
+//	// Pending email invitations created in the last week
+//	it := client.ListInvitations(ctx, vortex.ListOptions{
+//	    TargetType:   vortex.TargetEmail,
+//	    Status:       []vortex.InvitationStatus{vortex.StatusPending},
+//	    CreatedAfter: time.Now().AddDate(0, 0, -7),
+//	})
+//
+// The target and group filters cannot be combined.
+type ListOptions struct {
+	TargetType  TargetType
+	TargetValue string
+	// GroupType and GroupID must be set together
+	GroupType string
+	GroupID   string
+	// Status matches invitations in any of the given statuses
+	Status         []InvitationStatus
+	InvitationType string
+	CreatedAfter   time.Time
+	CreatedBefore  time.Time
+	// Deactivated and Expired match invitations with the flag set to the
+	// given value
+	Deactivated *bool
+	Expired     *bool
+	// PageSize defaults to DefaultPageSize
+	PageSize int
+	// Filters are applied to every page, e.g. TaggedWith or IncludeArchived
+	Filters []ListOption
+	// RequestOptions are applied to every page request
+	RequestOptions []RequestOption
+}
+
+// Bool returns a pointer to v, for setting ListOptions.Deactivated and
+// ListOptions.Expired
+func Bool(v bool) *bool {
+	return &v
+}
+
+// filterParams adds the query parameters for the filter fields
+func (o ListOptions) filterParams(queryParams map[string]string) {
+	if len(o.Status) > 0 {
+		statuses := make([]string, len(o.Status))
+		for i, status := range o.Status {
+			statuses[i] = string(status)
+		}
+		queryParams["status"] = strings.Join(statuses, ",")
+	}
+	if o.InvitationType != "" {
+		queryParams["invitationType"] = o.InvitationType
+	}
+	if !o.CreatedAfter.IsZero() {
+		queryParams["createdAfter"] = o.CreatedAfter.UTC().Format(time.RFC3339)
+	}
+	if !o.CreatedBefore.IsZero() {
+		queryParams["createdBefore"] = o.CreatedBefore.UTC().Format(time.RFC3339)
+	}
+	if o.Deactivated != nil {
+		queryParams["deactivated"] = strconv.FormatBool(*o.Deactivated)
+	}
+	if o.Expired != nil {
+		queryParams["expired"] = strconv.FormatBool(*o.Expired)
+	}
+}
+
+// InvitationPageFunc fetches the page of invitations starting at cursor, which
+// is empty for the first page, and returns the cursor of the next page or ""
+// after the last one
+type InvitationPageFunc func(ctx context.Context, cursor string) (invitations []InvitationResult, nextCursor string, err error)
+
+// InvitationIterator steps through invitations a page at a time
+//
+//	it := client.ListInvitations(ctx, vortex.ListOptions{GroupType: "team", GroupID: "team-1"})
+//	for it.Next() {
+//	    inv := it.Invitation()
+//	    // ...
+//	}
+//	if err := it.Err(); err != nil {
+//	    return err
+//	}
+type InvitationIterator struct {
+	pager
+	page []InvitationResult
+}
+
+// NewInvitationIterator creates an iterator over the pages fetch returns, e.g.
+// to return canned pages from a mock
+func NewInvitationIterator(ctx context.Context, fetch InvitationPageFunc) *InvitationIterator {
+	it := &InvitationIterator{}
+	it.pager = pager{ctx: ctx, fetch: func(ctx context.Context, cursor string) (int, string, error) {
+		page, next, err := fetch(ctx, cursor)
+		if err != nil {
+			return 0, "", err
+		}
+		it.page = page
+		return len(page), next, nil
+	}}
+	return it
+}
+
+// Next advances to the next invitation, fetching the next page when needed,
+// and reports whether there is one
+func (it *InvitationIterator) Next() bool {
+	return it.next()
+}
+
+// Invitation returns the current invitation; it is only valid after Next
+// returned true
+func (it *InvitationIterator) Invitation() InvitationResult {
+	return it.page[it.index]
+}
+
+// Err returns the error that stopped the iteration, if any
+func (it *InvitationIterator) Err() error {
+	return it.err
+}
+
+// pager steps through the items of a cursor-paginated listing; fetch loads
+// the page at cursor and returns its length and the next cursor
+type pager struct {
+	ctx   context.Context
+	fetch func(ctx context.Context, cursor string) (length int, nextCursor string, err error)
+
+	length  int
+	index   int
+	cursor  string
+	started bool
+	done    bool
+	err     error
+}
+
+// next advances to the next item, fetching the next page when needed, and
+// reports whether there is one
+func (p *pager) next() bool {
+	for p.index+1 >= p.length {
+		if p.done || p.err != nil {
+			p.length, p.index = 0, 0
+			return false
+		}
+		if p.started && p.cursor == "" {
+			p.done = true
+			continue
+		}
+		p.started = true
+
+		length, next, err := p.fetch(p.ctx, p.cursor)
+		if err != nil {
+			p.err = err
+			continue
+		}
+		// Guard against a server handing back the same cursor forever
+		if next != "" && next == p.cursor {
+			p.err = fmt.Errorf("vortex: pagination cursor %q did not advance", next)
+			continue
+		}
+		p.length, p.index, p.cursor = length, -1, next
+	}
+
+	p.index++
+	return true
+}
+
+// ErrInvalidListOptions is returned by ListInvitations for filters that
+// cannot be sent, such as a group and a target at once
+var ErrInvalidListOptions = errors.New("vortex: invalid list options")
+
+// failedIterator returns an iterator whose first call to Next fails with err
+func failedIterator(ctx context.Context, err error) *InvitationIterator {
+	return NewInvitationIterator(ctx, func(ctx context.Context, cursor string) ([]InvitationResult, string, error) {
+		return nil, "", err
+	})
+}
+
+// ListInvitations lists invitations a page at a time, so callers with many
+// invitations don't load them all into memory at once
+//
+// Pages follow the nextCursor the API returns. Endpoints that report hasMore
+// without a cursor are paged by offset instead, and endpoints that don't page
+// at all return a single page. Results are never served from the cache.
+func (c *Client) ListInvitations(ctx context.Context, opts ListOptions) *InvitationIterator {
+	path := "/api/v1/invitations"
+	queryParams := make(map[string]string)
+	grouped := opts.GroupType != "" || opts.GroupID != ""
+	targeted := opts.TargetType != "" || opts.TargetValue != ""
+	switch {
+	case grouped && targeted:
+		return failedIterator(ctx, fmt.Errorf("%w: the group and target filters cannot be combined", ErrInvalidListOptions))
+	case grouped:
+		if opts.GroupType == "" || opts.GroupID == "" {
+			return failedIterator(ctx, fmt.Errorf("%w: GroupType and GroupID must be set together", ErrInvalidListOptions))
+		}
+		path = fmt.Sprintf("/api/v1/invitations/by-group/%s/%s", opts.GroupType, opts.GroupID)
+	case targeted:
+		if !opts.TargetType.Valid() {
+			return failedIterator(ctx, fmt.Errorf("%w %q", ErrInvalidTargetType, opts.TargetType))
+		}
+		queryParams["targetType"] = string(opts.TargetType)
+		if opts.TargetValue != "" {
+			queryParams["targetValue"] = opts.TargetValue
+		}
+	}
+	opts.filterParams(queryParams)
+	for _, opt := range opts.Filters {
+		opt(queryParams)
+	}
+
+	pageSize := opts.PageSize
+	if pageSize <= 0 {
+		pageSize = DefaultPageSize
+	}
+	queryParams["limit"] = strconv.Itoa(pageSize)
+
+	offset := 0
+	return NewInvitationIterator(ctx, func(ctx context.Context, cursor string) ([]InvitationResult, string, error) {
+		params := make(map[string]string, len(queryParams)+1)
+		for k, v := range queryParams {
+			params[k] = v
+		}
+		if cursor != "" {
+			if offset > 0 {
+				params["offset"] = cursor
+			} else {
+				params["cursor"] = cursor
+			}
+		}
+
+		responseBody, err := c.apiRequestContext(ctx, "GET", path, nil, params, opts.RequestOptions...)
+		if err != nil {
+			return nil, "", err
+		}
+
+		var response InvitationsResponse
+		if err := c.decode(responseBody, &response); err != nil {
+			return nil, "", fmt.Errorf("failed to unmarshal response: %w", err)
+		}
+
+		if response.NextCursor != "" {
+			return response.Invitations, response.NextCursor, nil
+		}
+		if response.HasMore && len(response.Invitations) > 0 {
+			offset += len(response.Invitations)
+			return response.Invitations, strconv.Itoa(offset), nil
+		}
+		return response.Invitations, "", nil
+	})
+}
@@ -0,0 +1,215 @@
+package vortex
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func collectIDs(t *testing.T, it *InvitationIterator) []string {
+	t.Helper()
+	var ids []string
+	for it.Next() {
+		ids = append(ids, it.Invitation().ID)
+	}
+	return ids
+}
+
+func TestListInvitationsFollowsCursor(t *testing.T) {
+	pages := map[string]InvitationsResponse{
+		"":   {Invitations: []InvitationResult{{ID: "inv1"}, {ID: "inv2"}}, NextCursor: "c2"},
+		"c2": {Invitations: []InvitationResult{{ID: "inv3"}}, NextCursor: "c3"},
+		"c3": {Invitations: []InvitationResult{}},
+	}
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if r.URL.Path != "/api/v1/invitations/by-group/team/team-1" {
+			t.Errorf("Expected path /api/v1/invitations/by-group/team/team-1, got %s", r.URL.Path)
+		}
+		if r.URL.Query().Get("limit") != "2" {
+			t.Errorf("Expected limit 2, got %s", r.URL.Query().Get("limit"))
+		}
+		if r.URL.Query().Get("tags") != "beta" {
+			t.Errorf("Expected tags filter on every page, got %q", r.URL.Query().Get("tags"))
+		}
+		json.NewEncoder(w).Encode(pages[r.URL.Query().Get("cursor")])
+	}))
+	defer server.Close()
+
+	client := NewClientWithOptions("test-api-key", server.URL, nil)
+	it := client.ListInvitations(context.Background(), ListOptions{
+		GroupType: "team",
+		GroupID:   "team-1",
+		PageSize:  2,
+		Filters:   []ListOption{TaggedWith("beta")},
+	})
+
+	ids := collectIDs(t, it)
+	if err := it.Err(); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if len(ids) != 3 || ids[0] != "inv1" || ids[2] != "inv3" {
+		t.Errorf("Expected [inv1 inv2 inv3], got %v", ids)
+	}
+	if requests != 3 {
+		t.Errorf("Expected 3 requests, got %d", requests)
+	}
+	if it.Next() {
+		t.Error("Expected Next to stay false after the last page")
+	}
+}
+
+func TestListInvitationsFollowsOffset(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("targetType") != "email" || r.URL.Query().Get("targetValue") != "a@example.com" {
+			t.Errorf("Unexpected target query %s", r.URL.RawQuery)
+		}
+		switch r.URL.Query().Get("offset") {
+		case "":
+			json.NewEncoder(w).Encode(InvitationsResponse{Invitations: []InvitationResult{{ID: "inv1"}, {ID: "inv2"}}, HasMore: true})
+		case "2":
+			json.NewEncoder(w).Encode(InvitationsResponse{Invitations: []InvitationResult{{ID: "inv3"}}})
+		default:
+			t.Errorf("Unexpected offset %s", r.URL.Query().Get("offset"))
+		}
+	}))
+	defer server.Close()
+
+	client := NewClientWithOptions("test-api-key", server.URL, nil)
+	it := client.ListInvitations(context.Background(), ListOptions{TargetType: "email", TargetValue: "a@example.com"})
+
+	ids := collectIDs(t, it)
+	if err := it.Err(); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if len(ids) != 3 {
+		t.Errorf("Expected 3 invitations, got %v", ids)
+	}
+}
+
+func TestListInvitationsSinglePage(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if r.URL.Query().Get("limit") != "100" {
+			t.Errorf("Expected default limit 100, got %s", r.URL.Query().Get("limit"))
+		}
+		json.NewEncoder(w).Encode(InvitationsResponse{Invitations: []InvitationResult{{ID: "inv1"}}})
+	}))
+	defer server.Close()
+
+	client := NewClientWithOptions("test-api-key", server.URL, nil)
+	ids := collectIDs(t, client.ListInvitations(context.Background(), ListOptions{}))
+
+	if len(ids) != 1 || requests != 1 {
+		t.Errorf("Expected one invitation from one request, got %v from %d", ids, requests)
+	}
+}
+
+func TestListInvitationsFilters(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		want := map[string]string{
+			"targetType":     "email",
+			"status":         "pending,delivered",
+			"invitationType": "single_use",
+			"createdAfter":   "2026-03-01T00:00:00Z",
+			"createdBefore":  "2026-03-08T00:00:00Z",
+			"deactivated":    "false",
+			"expired":        "false",
+			"tags":           "beta",
+		}
+		query := r.URL.Query()
+		for key, value := range want {
+			if query.Get(key) != value {
+				t.Errorf("Expected %s=%s, got %q", key, value, query.Get(key))
+			}
+		}
+		if query.Has("targetValue") {
+			t.Errorf("Expected no targetValue, got %q", query.Get("targetValue"))
+		}
+		json.NewEncoder(w).Encode(InvitationsResponse{Invitations: []InvitationResult{{ID: "inv1"}}})
+	}))
+	defer server.Close()
+
+	client := NewClientWithOptions("test-api-key", server.URL, nil)
+	berlin := time.FixedZone("CET", 3600)
+	ids := collectIDs(t, client.ListInvitations(context.Background(), ListOptions{
+		TargetType:     TargetEmail,
+		Status:         []InvitationStatus{StatusPending, StatusDelivered},
+		InvitationType: "single_use",
+		CreatedAfter:   time.Date(2026, 3, 1, 1, 0, 0, 0, berlin),
+		CreatedBefore:  time.Date(2026, 3, 8, 0, 0, 0, 0, time.UTC),
+		Deactivated:    Bool(false),
+		Expired:        Bool(false),
+		Filters:        []ListOption{TaggedWith("beta")},
+	}))
+
+	if len(ids) != 1 {
+		t.Errorf("Expected one invitation, got %v", ids)
+	}
+}
+
+func TestInvitationIteratorError(t *testing.T) {
+	calls := 0
+	it := NewInvitationIterator(context.Background(), func(ctx context.Context, cursor string) ([]InvitationResult, string, error) {
+		calls++
+		if cursor == "" {
+			return []InvitationResult{{ID: "inv1"}}, "c2", nil
+		}
+		return nil, "", errors.New("boom")
+	})
+
+	ids := collectIDs(t, it)
+	if len(ids) != 1 {
+		t.Errorf("Expected the first page before the error, got %v", ids)
+	}
+	if it.Err() == nil || it.Err().Error() != "boom" {
+		t.Errorf("Expected boom, got %v", it.Err())
+	}
+	if it.Next() || calls != 2 {
+		t.Errorf("Expected no more fetches after an error, got %d", calls)
+	}
+}
+
+func TestInvitationIteratorStuckCursor(t *testing.T) {
+	it := NewInvitationIterator(context.Background(), func(ctx context.Context, cursor string) ([]InvitationResult, string, error) {
+		return []InvitationResult{{ID: "inv1"}}, "same", nil
+	})
+
+	ids := collectIDs(t, it)
+	if it.Err() == nil {
+		t.Error("Expected an error for a cursor that does not advance")
+	}
+	if len(ids) != 1 {
+		t.Errorf("Expected 1 invitation, got %v", ids)
+	}
+}
+
+func TestListInvitationsInvalidFilters(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("Expected no request, got %s", r.URL)
+	}))
+	defer server.Close()
+
+	client := NewClientWithOptions("test-api-key", server.URL, nil)
+
+	tests := map[string]ListOptions{
+		"group and target": {GroupType: "team", GroupID: "team-1", TargetType: TargetEmail, TargetValue: "ada@example.com"},
+		"empty group ID":   {GroupType: "team"},
+		"empty group type": {GroupID: "team-1"},
+	}
+	for name, opts := range tests {
+		it := client.ListInvitations(context.Background(), opts)
+		if it.Next() {
+			t.Errorf("%s: Expected no invitations", name)
+		}
+		if !errors.Is(it.Err(), ErrInvalidListOptions) {
+			t.Errorf("%s: Expected ErrInvalidListOptions, got %v", name, it.Err())
+		}
+	}
+}
@@ -0,0 +1,99 @@
+package vortex
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestGroupPolicy(t *testing.T) {
+	var stored *GroupPolicy
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v1/groups/workspace/ws-123/policy" {
+			t.Errorf("Expected path /api/v1/groups/workspace/ws-123/policy, got %s", r.URL.Path)
+		}
+		switch r.Method {
+		case "PUT":
+			stored = &GroupPolicy{}
+			if err := json.NewDecoder(r.Body).Decode(stored); err != nil {
+				t.Errorf("Failed to decode request body: %v", err)
+			}
+		case "DELETE":
+			stored = nil
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		if stored == nil {
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"error": "policy not found"}`))
+			return
+		}
+		json.NewEncoder(w).Encode(stored)
+	}))
+	defer server.Close()
+
+	client := NewClientWithOptions("test-api-key", server.URL, nil)
+	ctx := context.Background()
+	group := GroupRef{Type: "workspace", GroupID: "ws-123"}
+
+	policy, err := client.SetGroupPolicy(ctx, group, GroupPolicy{
+		AllowedDomains:       []string{"example.com"},
+		RequireAdminApproval: true,
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if !policy.RequireAdminApproval {
+		t.Error("Expected admin approval to be required")
+	}
+
+	policy, err = client.GetGroupPolicy(ctx, group)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if len(policy.AllowedDomains) != 1 || policy.AllowedDomains[0] != "example.com" {
+		t.Errorf("Expected allowed domains [example.com], got %v", policy.AllowedDomains)
+	}
+
+	if err := client.DeleteGroupPolicy(ctx, group); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if _, err := client.GetGroupPolicy(ctx, group); err == nil {
+		t.Error("Expected an error after deleting the policy")
+	}
+}
+
+func TestInvitationResultEffectivePolicy(t *testing.T) {
+	apiResponse := `{
+		"id": "inv-1",
+		"status": "blocked",
+		"effectivePolicy": {
+			"allowedDomains": ["example.com"],
+			"requireAdminApproval": false,
+			"group": {"type": "workspace", "groupId": "ws-123"},
+			"blockedReason": "target domain is not allowed"
+		}
+	}`
+
+	var invitation InvitationResult
+	if err := json.Unmarshal([]byte(apiResponse), &invitation); err != nil {
+		t.Fatalf("Failed to unmarshal InvitationResult: %v", err)
+	}
+
+	policy := invitation.EffectivePolicy
+	if policy == nil {
+		t.Fatal("Expected effectivePolicy to be present")
+	}
+	if len(policy.AllowedDomains) != 1 {
+		t.Errorf("Expected 1 allowed domain, got %v", policy.AllowedDomains)
+	}
+	if policy.Group == nil || policy.Group.GroupID != "ws-123" {
+		t.Errorf("Expected policy from group 'ws-123', got %+v", policy.Group)
+	}
+	if policy.BlockedReason != "target domain is not allowed" {
+		t.Errorf("Expected blocked reason, got '%s'", policy.BlockedReason)
+	}
+}
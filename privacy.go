@@ -0,0 +1,83 @@
+package vortex
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+)
+
+// ErasureReport records what was removed by EraseTargetData, for compliance
+// records
+type ErasureReport struct {
+	ID     string           `json:"id"`
+	Target InvitationTarget `json:"target"`
+	// InvitationsDeleted is the number of invitations sent to the target
+	InvitationsDeleted int `json:"invitationsDeleted"`
+	// AcceptancesDeleted is the number of acceptances made by the target
+	AcceptancesDeleted int `json:"acceptancesDeleted"`
+	// EventsAnonymized is the number of analytics events that were kept for
+	// aggregate reporting with the target removed
+	EventsAnonymized int       `json:"eventsAnonymized"`
+	CompletedAt      time.Time `json:"completedAt"`
+}
+
+// EraseTargetData deletes or anonymizes every invitation, acceptance and
+// analytics event tied to an email address or phone number
+//
+// The erasure cannot be undone. The whole cache is cleared afterwards since
+// the affected invitations are not known up front.
+//
+// Example:
+//
+//	report, err := client.EraseTargetData(ctx, vortex.InvitationTarget{Type: "email", Value: "user@example.com"})
+//	if err != nil {
+//	    return err
+//	}
+//	log.Printf("erasure %s removed %d invitations", report.ID, report.InvitationsDeleted)
+func (c *Client) EraseTargetData(ctx context.Context, target InvitationTarget, opts ...RequestOption) (*ErasureReport, error) {
+	requestBody := map[string]InvitationTarget{
+		"target": target,
+	}
+
+	responseBody, err := c.apiRequestContext(ctx, "POST", "/api/v1/privacy/erasure", requestBody, nil, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	if c.cache != nil {
+		c.cache.Clear()
+	}
+
+	var report ErasureReport
+	if err := c.decode(responseBody, &report); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	return &report, nil
+}
+
+// ExportTargetData writes a JSON bundle of all data held about an email
+// address or phone number to w, for serving data subject access requests
+//
+// The bundle is streamed to w and not subject to WithMaxResponseSize. If the
+// call fails midway, w may hold part of the bundle.
+//
+// Example:
+//
+//	f, err := os.Create("dsar-user@example.com.json")
+//	if err != nil {
+//	    return err
+//	}
+//	defer f.Close()
+//	err = client.ExportTargetData(ctx, vortex.InvitationTarget{Type: "email", Value: "user@example.com"}, f)
+func (c *Client) ExportTargetData(ctx context.Context, target InvitationTarget, w io.Writer, opts ...RequestOption) error {
+	queryParams := map[string]string{
+		"targetType":  string(target.Type),
+		"targetValue": target.Value,
+	}
+
+	opts = append(opts[:len(opts):len(opts)], streamTo(w))
+	_, err := c.send(ctx, "GET", "/api/v1/privacy/export", nil, queryParams, opts)
+	return err
+}
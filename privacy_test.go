@@ -0,0 +1,118 @@
+package vortex
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestEraseTargetData(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if r.Method == "GET" {
+			w.Write([]byte(`{"id": "inv1"}`))
+			return
+		}
+		if r.Method != "POST" || r.URL.Path != "/api/v1/privacy/erasure" {
+			t.Errorf("Expected POST /api/v1/privacy/erasure, got %s %s", r.Method, r.URL.Path)
+		}
+
+		var req map[string]InvitationTarget
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Errorf("Failed to decode request body: %v", err)
+		}
+		if req["target"].Value != "user@example.com" {
+			t.Errorf("Expected target 'user@example.com', got %+v", req["target"])
+		}
+
+		w.Write([]byte(`{
+			"id": "erasure-1",
+			"target": {"type": "email", "value": "user@example.com"},
+			"invitationsDeleted": 3,
+			"acceptancesDeleted": 1,
+			"eventsAnonymized": 12,
+			"completedAt": "2024-01-01T00:00:00Z"
+		}`))
+	}))
+	defer server.Close()
+
+	client := NewClientWithOptions("test-api-key", server.URL, nil, WithCache(NewLRUCache(10, time.Minute)))
+	ctx := context.Background()
+
+	if _, err := client.GetInvitation("inv1"); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	report, err := client.EraseTargetData(ctx, InvitationTarget{Type: "email", Value: "user@example.com"})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if report.ID != "erasure-1" || report.InvitationsDeleted != 3 || report.EventsAnonymized != 12 {
+		t.Errorf("Unexpected report %+v", report)
+	}
+
+	if _, err := client.GetInvitation("inv1"); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if requests != 3 {
+		t.Errorf("Expected the cache to be cleared (3 requests), got %d", requests)
+	}
+}
+
+func TestExportTargetData(t *testing.T) {
+	bundle := `{"target": {"type": "email", "value": "user@example.com"}, "invitations": [{"id": "inv1"}]}`
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "GET" || r.URL.Path != "/api/v1/privacy/export" {
+			t.Errorf("Expected GET /api/v1/privacy/export, got %s %s", r.Method, r.URL.Path)
+		}
+		if r.URL.Query().Get("targetValue") != "user@example.com" {
+			t.Errorf("Expected targetValue 'user@example.com', got %s", r.URL.Query().Get("targetValue"))
+		}
+		w.Write([]byte(bundle))
+	}))
+	defer server.Close()
+
+	client := NewClientWithOptions("test-api-key", server.URL, nil)
+
+	var buf bytes.Buffer
+	err := client.ExportTargetData(context.Background(), InvitationTarget{Type: "email", Value: "user@example.com"}, &buf)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if buf.String() != bundle {
+		t.Errorf("Expected the export bundle, got %s", buf.String())
+	}
+}
+
+func TestExportTargetData_LargerThanMaxResponseSize(t *testing.T) {
+	bundle := `{"invitations": [` + strings.Repeat(`{"id": "inv"},`, 10000) + `{"id": "last"}]}`
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(bundle))
+	}))
+	defer server.Close()
+
+	client := NewClientWithOptions("test-api-key", server.URL, nil, WithMaxResponseSize(1024))
+
+	var buf bytes.Buffer
+	err := client.ExportTargetData(context.Background(), InvitationTarget{Type: "email", Value: "user@example.com"}, &buf)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if buf.String() != bundle {
+		t.Errorf("Expected the whole %d byte bundle, got %d bytes", len(bundle), buf.Len())
+	}
+
+	// Other calls are still limited
+	if _, err := client.GetInvitation("inv-1"); !errors.Is(err, ErrResponseTooLarge) {
+		t.Errorf("Expected ErrResponseTooLarge, got %v", err)
+	}
+}
@@ -0,0 +1,245 @@
+package vortex
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// RateLimiter throttles outbound API calls
+//
+// *rate.Limiter from golang.org/x/time/rate satisfies this interface.
+type RateLimiter interface {
+	Wait(ctx context.Context) error
+}
+
+// EndpointClass groups API endpoints that share a rate limit
+type EndpointClass string
+
+const (
+	// EndpointRead covers GET requests
+	EndpointRead EndpointClass = "read"
+	// EndpointWrite covers requests that create, change or delete data
+	EndpointWrite EndpointClass = "write"
+)
+
+// WithRateLimiter throttles calls in the given endpoint classes, or all calls
+// if no class is given
+//
+// Calls wait for the limiter before being sent, so bulk jobs stay below the
+// plan's limits instead of tripping server-side 429s.
+//
+// Example:
+//
+//	client := vortex.NewClient(apiKey,
+//	    vortex.WithRateLimiter(vortex.NewTokenBucket(50, 10), vortex.EndpointRead),
+//	    vortex.WithRateLimiter(rate.NewLimiter(5, 1), vortex.EndpointWrite),
+//	)
+func WithRateLimiter(limiter RateLimiter, classes ...EndpointClass) ClientOption {
+	return func(c *Client) {
+		if len(classes) == 0 {
+			classes = []EndpointClass{EndpointRead, EndpointWrite}
+		}
+		if c.limiters == nil {
+			c.limiters = make(map[EndpointClass]RateLimiter)
+		}
+		for _, class := range classes {
+			c.limiters[class] = limiter
+		}
+	}
+}
+
+// waitForLimiter blocks until the limiter for the request's endpoint class allows it
+func (c *Client) waitForLimiter(ctx context.Context, method string) error {
+	limiter, ok := c.limiters[endpointClass(method)]
+	if !ok {
+		return nil
+	}
+	if err := limiter.Wait(ctx); err != nil {
+		return fmt.Errorf("rate limiter: %w", err)
+	}
+	return nil
+}
+
+// WithMaxConcurrentRequests allows at most n requests in flight at once;
+// further calls wait for a slot or for their context to be done
+//
+// This keeps a burst of goroutines from opening hundreds of connections to
+// Vortex at the same time. A slot is held until the response body has been read.
+func WithMaxConcurrentRequests(n int) ClientOption {
+	return func(c *Client) {
+		if n > 0 {
+			c.inflight = make(chan struct{}, n)
+		} else {
+			c.inflight = nil
+		}
+	}
+}
+
+// acquireSlot waits for a free request slot, returning the function that
+// releases it
+func (c *Client) acquireSlot(ctx context.Context) (func(), error) {
+	if c.inflight == nil {
+		return func() {}, nil
+	}
+	select {
+	case c.inflight <- struct{}{}:
+		return func() { <-c.inflight }, nil
+	case <-ctx.Done():
+		return nil, fmt.Errorf("waiting for a request slot: %w", ctx.Err())
+	}
+}
+
+func endpointClass(method string) EndpointClass {
+	if method == http.MethodGet || method == http.MethodHead {
+		return EndpointRead
+	}
+	return EndpointWrite
+}
+
+// TokenBucket is a RateLimiter allowing rate calls per second with bursts of up to burst calls
+type TokenBucket struct {
+	mu     sync.Mutex
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+}
+
+// NewTokenBucket creates a full token bucket
+//
+// It panics if rate is not positive or burst is less than 1, since such a
+// bucket would never allow a call.
+func NewTokenBucket(rate float64, burst int) *TokenBucket {
+	if rate <= 0 || math.IsNaN(rate) || math.IsInf(rate, 0) {
+		panic("vortex: token bucket rate must be positive and finite")
+	}
+	if burst < 1 {
+		panic("vortex: token bucket burst must be at least 1")
+	}
+
+	return &TokenBucket{
+		rate:   rate,
+		burst:  float64(burst),
+		tokens: float64(burst),
+		last:   time.Now(),
+	}
+}
+
+// Wait implements RateLimiter
+func (b *TokenBucket) Wait(ctx context.Context) error {
+	for {
+		acquired, delay := b.reserve()
+		if acquired {
+			return nil
+		}
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// reserve takes a token if one is available, or returns how long until one is
+func (b *TokenBucket) reserve() (bool, time.Duration) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	now := time.Now()
+	b.tokens += now.Sub(b.last).Seconds() * b.rate
+	if b.tokens > b.burst {
+		b.tokens = b.burst
+	}
+	b.last = now
+
+	if b.tokens >= 1 {
+		b.tokens--
+		return true, 0
+	}
+
+	delay := time.Duration((1 - b.tokens) / b.rate * float64(time.Second))
+	if delay < time.Millisecond {
+		delay = time.Millisecond
+	}
+	return false, delay
+}
+
+// RateLimit is the server-side rate limit state reported with a response
+type RateLimit struct {
+	// Limit is the number of calls allowed per window, or -1 if not reported
+	Limit int `json:"limit"`
+	// Remaining is the number of calls left in the current window
+	Remaining int `json:"remaining"`
+	// Reset is when the window resets and Remaining is back at Limit
+	Reset time.Time `json:"reset"`
+}
+
+// rateLimitState holds the most recent RateLimit seen by a client and the
+// clients derived from it with AsUser
+type rateLimitState struct {
+	mu   sync.Mutex
+	last *RateLimit
+}
+
+// LastRateLimit returns the rate limit reported with the most recent response,
+// or nil if no response carried X-RateLimit headers yet
+//
+// Bulk jobs can use it to slow down before hitting 429s:
+//
+//	if rl := client.LastRateLimit(); rl != nil && rl.Remaining < 10 {
+//	    time.Sleep(time.Until(rl.Reset))
+//	}
+func (c *Client) LastRateLimit() *RateLimit {
+	if c.rateLimits == nil {
+		return nil
+	}
+	c.rateLimits.mu.Lock()
+	defer c.rateLimits.mu.Unlock()
+	if c.rateLimits.last == nil {
+		return nil
+	}
+	rl := *c.rateLimits.last
+	return &rl
+}
+
+func (c *Client) recordRateLimit(rl *RateLimit) {
+	if rl == nil || c.rateLimits == nil {
+		return
+	}
+	c.rateLimits.mu.Lock()
+	c.rateLimits.last = rl
+	c.rateLimits.mu.Unlock()
+}
+
+// parseRateLimit reads the X-RateLimit headers, returning nil if
+// X-RateLimit-Remaining is missing
+//
+// X-RateLimit-Reset may be a Unix timestamp or a number of seconds from now.
+func parseRateLimit(header http.Header, now time.Time) *RateLimit {
+	remaining, err := strconv.Atoi(header.Get("X-RateLimit-Remaining"))
+	if err != nil {
+		return nil
+	}
+
+	rl := &RateLimit{Limit: -1, Remaining: remaining}
+	if limit, err := strconv.Atoi(header.Get("X-RateLimit-Limit")); err == nil {
+		rl.Limit = limit
+	}
+	if reset, err := strconv.ParseInt(header.Get("X-RateLimit-Reset"), 10, 64); err == nil && reset >= 0 {
+		// Delays are far smaller than any timestamp since 2001
+		if reset > 1e9 {
+			rl.Reset = time.Unix(reset, 0)
+		} else {
+			rl.Reset = now.Add(time.Duration(reset) * time.Second)
+		}
+	}
+	return rl
+}
@@ -0,0 +1,242 @@
+package vortex
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+)
+
+type countingLimiter struct {
+	calls int
+	err   error
+}
+
+func (l *countingLimiter) Wait(ctx context.Context) error {
+	l.calls++
+	return l.err
+}
+
+func TestWithRateLimiter_EndpointClass(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"invitations":[]}`))
+	}))
+	defer server.Close()
+
+	reads := &countingLimiter{}
+	writes := &countingLimiter{}
+	client := NewClientWithOptions("test-api-key", server.URL, nil,
+		WithRateLimiter(reads, EndpointRead),
+		WithRateLimiter(writes, EndpointWrite),
+	)
+
+	client.GetInvitationsByGroup("workspace", "ws-1")
+	client.GetInvitation("inv-1")
+	client.RevokeInvitation("inv-1")
+
+	if reads.calls != 2 {
+		t.Errorf("Expected 2 read limiter calls, got %d", reads.calls)
+	}
+	if writes.calls != 1 {
+		t.Errorf("Expected 1 write limiter call, got %d", writes.calls)
+	}
+}
+
+func TestWithRateLimiter_Error(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+	}))
+	defer server.Close()
+
+	limiter := &countingLimiter{err: context.DeadlineExceeded}
+	client := NewClientWithOptions("test-api-key", server.URL, nil, WithRateLimiter(limiter))
+
+	err := client.RevokeInvitation("inv-1")
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Expected context.DeadlineExceeded, got %v", err)
+	}
+	if requests != 0 {
+		t.Errorf("Expected no requests, got %d", requests)
+	}
+}
+
+func TestTokenBucket(t *testing.T) {
+	bucket := NewTokenBucket(100, 2)
+	ctx := context.Background()
+
+	start := time.Now()
+	for i := 0; i < 4; i++ {
+		if err := bucket.Wait(ctx); err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+	}
+
+	// Two calls use the burst, the other two wait about 10ms each
+	if elapsed := time.Since(start); elapsed < 15*time.Millisecond {
+		t.Errorf("Expected calls beyond the burst to wait, took %v", elapsed)
+	}
+
+	empty := NewTokenBucket(1, 1)
+	empty.Wait(context.Background())
+
+	ctx, cancel := context.WithCancel(ctx)
+	cancel()
+	if err := empty.Wait(ctx); !errors.Is(err, context.Canceled) {
+		t.Errorf("Expected context.Canceled, got %v", err)
+	}
+}
+
+func TestNewTokenBucket_Invalid(t *testing.T) {
+	tests := []struct {
+		name  string
+		rate  float64
+		burst int
+	}{
+		{"zero rate", 0, 1},
+		{"negative rate", -1, 1},
+		{"zero burst", 10, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("Expected NewTokenBucket(%v, %d) to panic", tt.rate, tt.burst)
+				}
+			}()
+			NewTokenBucket(tt.rate, tt.burst)
+		})
+	}
+}
+
+func TestWithMaxConcurrentRequests(t *testing.T) {
+	var mu sync.Mutex
+	inFlight, peak := 0, 0
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		inFlight++
+		if inFlight > peak {
+			peak = inFlight
+		}
+		mu.Unlock()
+
+		time.Sleep(20 * time.Millisecond)
+
+		mu.Lock()
+		inFlight--
+		mu.Unlock()
+		w.Write([]byte(`{"id": "inv1"}`))
+	}))
+	defer server.Close()
+
+	client := NewClientWithOptions("test-api-key", server.URL, nil, WithMaxConcurrentRequests(2))
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := client.GetInvitation("inv1"); err != nil {
+				t.Errorf("Expected no error, got %v", err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if peak > 2 {
+		t.Errorf("Expected at most 2 requests in flight, got %d", peak)
+	}
+}
+
+func TestWithMaxConcurrentRequests_ContextDone(t *testing.T) {
+	client := NewClientWithOptions("test-api-key", "http://localhost:0", nil, WithMaxConcurrentRequests(1))
+
+	// Occupy the only slot
+	release, err := client.acquireSlot(context.Background())
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	defer release()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	if _, err := client.apiRequestContext(ctx, "GET", "/api/v1/invitations/inv1", nil, nil); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Expected context.DeadlineExceeded, got %v", err)
+	}
+}
+
+func TestLastRateLimit(t *testing.T) {
+	reset := time.Now().Add(time.Minute).Unix()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-RateLimit-Limit", "100")
+		w.Header().Set("X-RateLimit-Remaining", "42")
+		w.Header().Set("X-RateLimit-Reset", strconv.FormatInt(reset, 10))
+		w.Write([]byte(`{"id":"inv-1"}`))
+	}))
+	defer server.Close()
+
+	client := NewClientWithOptions("test-api-key", server.URL, nil)
+	if client.LastRateLimit() != nil {
+		t.Fatal("Expected no rate limit before the first response")
+	}
+
+	client.GetInvitation("inv-1")
+
+	rl := client.AsUser(&User{ID: "user-1"}).LastRateLimit()
+	if rl == nil {
+		t.Fatal("Expected a rate limit shared with AsUser clients")
+	}
+	if rl.Limit != 100 || rl.Remaining != 42 || rl.Reset.Unix() != reset {
+		t.Errorf("Unexpected rate limit %+v", rl)
+	}
+}
+
+func TestRateLimitOnAPIError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-RateLimit-Remaining", "0")
+		w.Header().Set("X-RateLimit-Reset", "30")
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer server.Close()
+
+	client := NewClientWithOptions("test-api-key", server.URL, nil)
+	_, err := client.GetInvitation("inv-1")
+
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("Expected APIError, got %v", err)
+	}
+	if apiErr.RateLimit == nil || apiErr.RateLimit.Remaining != 0 || apiErr.RateLimit.Limit != -1 {
+		t.Errorf("Unexpected rate limit %+v", apiErr.RateLimit)
+	}
+	if apiErr.RetryAfter < 29*time.Second || apiErr.RetryAfter > 30*time.Second {
+		t.Errorf("Expected RetryAfter from X-RateLimit-Reset, got %v", apiErr.RetryAfter)
+	}
+}
+
+func TestParseRateLimit(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+
+	if rl := parseRateLimit(http.Header{}, now); rl != nil {
+		t.Errorf("Expected nil without headers, got %+v", rl)
+	}
+
+	header := http.Header{}
+	header.Set("X-RateLimit-Remaining", "5")
+	header.Set("X-RateLimit-Reset", "1700000060")
+	if rl := parseRateLimit(header, now); !rl.Reset.Equal(now.Add(time.Minute)) {
+		t.Errorf("Expected timestamp reset, got %v", rl.Reset)
+	}
+
+	header.Set("X-RateLimit-Reset", "60")
+	if rl := parseRateLimit(header, now); !rl.Reset.Equal(now.Add(time.Minute)) {
+		t.Errorf("Expected relative reset, got %v", rl.Reset)
+	}
+}
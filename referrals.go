@@ -0,0 +1,65 @@
+package vortex
+
+import (
+	"context"
+	"fmt"
+)
+
+// Referral reward states
+const (
+	RewardPending  = "pending"
+	RewardEarned   = "earned"
+	RewardPaid     = "paid"
+	RewardRejected = "rejected"
+)
+
+// Referral attributes an invitation to the user and campaign that drove it
+type Referral struct {
+	// ReferrerID is your ID for the referring user
+	ReferrerID string `json:"referrerId"`
+	Campaign   string `json:"campaign,omitempty"`
+	// RewardState is one of the Reward* values
+	RewardState string `json:"rewardState,omitempty"`
+}
+
+// ReferredBy limits list calls to invitations referred by a user
+func ReferredBy(referrerID string) ListOption {
+	return func(queryParams map[string]string) {
+		queryParams["referrerId"] = referrerID
+	}
+}
+
+// InCampaign limits list calls to invitations from a referral campaign
+func InCampaign(campaign string) ListOption {
+	return func(queryParams map[string]string) {
+		queryParams["campaign"] = campaign
+	}
+}
+
+// SetReferralRewardState records the state of the reward for a referred
+// invitation, e.g. once the referrer has been paid
+//
+// Example:
+//
+//	invitation, err := client.SetReferralRewardState(ctx, "invitation-id", vortex.RewardPaid)
+func (c *Client) SetReferralRewardState(ctx context.Context, invitationID, state string, opts ...RequestOption) (*InvitationResult, error) {
+	path := fmt.Sprintf("/api/v1/invitations/%s/referral", invitationID)
+
+	requestBody := map[string]string{
+		"rewardState": state,
+	}
+
+	responseBody, err := c.apiRequestContext(ctx, "PATCH", path, requestBody, nil, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	c.invalidateInvitation(invitationID)
+
+	var result InvitationResult
+	if err := c.decode(responseBody, &result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	return &result, nil
+}
@@ -0,0 +1,64 @@
+package vortex
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestReferralFilters(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		if query.Get("referrerId") != "user-1" || query.Get("campaign") != "spring" {
+			t.Errorf("Expected referral filters, got %s", r.URL.RawQuery)
+		}
+		w.Write([]byte(`{"invitations": [
+			{"id": "inv1", "referral": {"referrerId": "user-1", "campaign": "spring", "rewardState": "earned"}}
+		]}`))
+	}))
+	defer server.Close()
+
+	client := NewClientWithOptions("test-api-key", server.URL, nil)
+
+	invitations, err := client.GetInvitationsByGroup("workspace", "ws-123", ReferredBy("user-1"), InCampaign("spring"))
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if len(invitations) != 1 || invitations[0].Referral == nil {
+		t.Fatalf("Expected 1 referred invitation, got %+v", invitations)
+	}
+	if invitations[0].Referral.RewardState != RewardEarned {
+		t.Errorf("Expected reward state 'earned', got %s", invitations[0].Referral.RewardState)
+	}
+}
+
+func TestSetReferralRewardState(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "PATCH" || r.URL.Path != "/api/v1/invitations/inv1/referral" {
+			t.Errorf("Expected PATCH /api/v1/invitations/inv1/referral, got %s %s", r.Method, r.URL.Path)
+		}
+
+		var req map[string]string
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Errorf("Failed to decode request body: %v", err)
+		}
+
+		json.NewEncoder(w).Encode(InvitationResult{
+			ID:       "inv1",
+			Referral: &Referral{ReferrerID: "user-1", RewardState: req["rewardState"]},
+		})
+	}))
+	defer server.Close()
+
+	client := NewClientWithOptions("test-api-key", server.URL, nil)
+
+	result, err := client.SetReferralRewardState(context.Background(), "inv1", RewardPaid)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if result.Referral.RewardState != RewardPaid {
+		t.Errorf("Expected reward state 'paid', got %s", result.Referral.RewardState)
+	}
+}
@@ -0,0 +1,78 @@
+package vortex
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// Reminder is a reminder scheduled on an invitation
+type Reminder struct {
+	ID           string `json:"id"`
+	InvitationID string `json:"invitationId"`
+	// SendAt is when the reminder will be sent
+	SendAt time.Time `json:"sendAt"`
+	// Status is "scheduled", "sent" or "cancelled"
+	Status string `json:"status"`
+}
+
+// RemindersResponse represents the API response for reminder lists
+type RemindersResponse struct {
+	Reminders []Reminder `json:"reminders"`
+}
+
+// ScheduleReminders schedules reminders on an invitation, each sent after the
+// given delay from now unless the invitation has been accepted by then
+//
+// Example:
+//
+//	// Remind after 3 and 7 days
+//	reminders, err := client.ScheduleReminders(ctx, "invitation-id", 72*time.Hour, 168*time.Hour)
+func (c *Client) ScheduleReminders(ctx context.Context, invitationID string, delays ...time.Duration) ([]Reminder, error) {
+	path := fmt.Sprintf("/api/v1/invitations/%s/reminders", invitationID)
+
+	afterSeconds := make([]int64, len(delays))
+	for i, delay := range delays {
+		afterSeconds[i] = int64(delay / time.Second)
+	}
+	requestBody := map[string]interface{}{
+		"afterSeconds": afterSeconds,
+	}
+
+	responseBody, err := c.apiRequestContext(ctx, "POST", path, requestBody, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var response RemindersResponse
+	if err := c.decode(responseBody, &response); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	return response.Reminders, nil
+}
+
+// ListReminders retrieves the reminders scheduled on an invitation
+func (c *Client) ListReminders(ctx context.Context, invitationID string, opts ...RequestOption) ([]Reminder, error) {
+	path := fmt.Sprintf("/api/v1/invitations/%s/reminders", invitationID)
+
+	responseBody, err := c.apiRequestContext(ctx, "GET", path, nil, nil, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	var response RemindersResponse
+	if err := c.decode(responseBody, &response); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	return response.Reminders, nil
+}
+
+// CancelReminder cancels a scheduled reminder
+func (c *Client) CancelReminder(ctx context.Context, invitationID, reminderID string, opts ...RequestOption) error {
+	path := fmt.Sprintf("/api/v1/invitations/%s/reminders/%s", invitationID, reminderID)
+
+	_, err := c.apiRequestContext(ctx, "DELETE", path, nil, nil, opts...)
+	return err
+}
@@ -0,0 +1,67 @@
+package vortex
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestReminders(t *testing.T) {
+	var cancelled string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == "POST" && r.URL.Path == "/api/v1/invitations/inv-1/reminders":
+			var body struct {
+				AfterSeconds []int64 `json:"afterSeconds"`
+			}
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				t.Errorf("Failed to decode request body: %v", err)
+			}
+			if len(body.AfterSeconds) != 2 || body.AfterSeconds[0] != 259200 || body.AfterSeconds[1] != 604800 {
+				t.Errorf("Expected delays [259200 604800], got %v", body.AfterSeconds)
+			}
+			w.Write([]byte(`{"reminders": [
+				{"id": "rem-1", "invitationId": "inv-1", "status": "scheduled"},
+				{"id": "rem-2", "invitationId": "inv-1", "status": "scheduled"}
+			]}`))
+		case r.Method == "GET" && r.URL.Path == "/api/v1/invitations/inv-1/reminders":
+			w.Write([]byte(`{"reminders": [{"id": "rem-1", "invitationId": "inv-1", "status": "scheduled"}]}`))
+		case r.Method == "DELETE":
+			cancelled = r.URL.Path
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("Unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClientWithOptions("test-api-key", server.URL, nil)
+	ctx := context.Background()
+
+	reminders, err := client.ScheduleReminders(ctx, "inv-1", 72*time.Hour, 7*24*time.Hour)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if len(reminders) != 2 {
+		t.Errorf("Expected 2 reminders, got %d", len(reminders))
+	}
+
+	reminders, err = client.ListReminders(ctx, "inv-1")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if len(reminders) != 1 || reminders[0].ID != "rem-1" {
+		t.Errorf("Expected reminder 'rem-1', got %+v", reminders)
+	}
+
+	if err := client.CancelReminder(ctx, "inv-1", "rem-1"); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if cancelled != "/api/v1/invitations/inv-1/reminders/rem-1" {
+		t.Errorf("Expected reminder 'rem-1' to be cancelled, got %s", cancelled)
+	}
+}
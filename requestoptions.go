@@ -0,0 +1,158 @@
+package vortex
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+)
+
+// RequestOption changes a single API call
+//
+// Every API method accepts RequestOptions. Methods whose last parameter is
+// already variadic, such as GetInvitationsByTargetContext, take them from the
+// context instead; see WithRequestOptions.
+type RequestOption func(*requestOptions)
+
+type requestOptions struct {
+	header  http.Header
+	query   url.Values
+	capture *ResponseMetadata
+	// sink receives the body of a successful response instead of it being
+	// read into memory
+	sink io.Writer
+}
+
+// Header sets a request header, e.g. a correlation ID
+//
+// Example:
+//
+//	invitation, err := client.GetInvitationContext(ctx, id, vortex.Header("X-Correlation-ID", correlationID))
+func Header(key, value string) RequestOption {
+	return func(o *requestOptions) {
+		o.header.Set(key, value)
+	}
+}
+
+// QueryParam sets a query parameter, for filters the SDK does not model yet
+func QueryParam(key, value string) RequestOption {
+	return func(o *requestOptions) {
+		o.query.Set(key, value)
+	}
+}
+
+// IdempotencyKey sends an Idempotency-Key header, so the API applies a
+// mutation only once however often it is retried with the same key
+//
+// CreateInvitation, AcceptInvitations and Reinvite send a random key
+// unless one is given. A random key only covers the SDK's own retries; pass
+// a key derived from your job or message ID to cover retries of the whole
+// operation.
+//
+// Requests carrying a key are retried by a RetryPolicy even when
+// RetryMutations is off.
+func IdempotencyKey(key string) RequestOption {
+	return Header("Idempotency-Key", key)
+}
+
+// APIKey sends the call with a different API key than the client's, e.g. for
+// a platform acting on its tenants' Vortex projects
+//
+// JWTs minted by the client, including those AsUser sends, are still signed
+// with the client's own key; MultiTenantClient keeps a client per key instead.
+//
+// Example:
+//
+//	invitation, err := client.GetInvitationContext(ctx, id, vortex.APIKey(tenant.VortexAPIKey))
+func APIKey(key string) RequestOption {
+	return Header("x-api-key", key)
+}
+
+// streamTo copies the body of a successful response to w as it arrives,
+// without the WithMaxResponseSize limit
+func streamTo(w io.Writer) RequestOption {
+	return func(o *requestOptions) {
+		o.sink = w
+	}
+}
+
+type requestOptionsKey struct{}
+
+// WithRequestOptions returns a context that applies opts to every API call
+// made with it, after the client's defaults and before the options passed to
+// the call itself
+//
+// It reaches methods that cannot take RequestOptions directly, and calls made
+// on your behalf by packages such as vortexcache.
+//
+// Example:
+//
+//	ctx = vortex.WithRequestOptions(ctx, vortex.Header("X-Correlation-ID", correlationID))
+//	invitations, err := client.GetInvitationsByTargetContext(ctx, "email", email, vortex.IncludeArchived())
+func WithRequestOptions(ctx context.Context, opts ...RequestOption) context.Context {
+	existing, _ := ctx.Value(requestOptionsKey{}).([]RequestOption)
+	merged := make([]RequestOption, 0, len(existing)+len(opts))
+	merged = append(merged, existing...)
+	merged = append(merged, opts...)
+	return context.WithValue(ctx, requestOptionsKey{}, merged)
+}
+
+// withContextOptions prepends the options carried by ctx to opts
+func withContextOptions(ctx context.Context, opts []RequestOption) []RequestOption {
+	existing, _ := ctx.Value(requestOptionsKey{}).([]RequestOption)
+	if len(existing) == 0 {
+		return opts
+	}
+	return append(append([]RequestOption(nil), existing...), opts...)
+}
+
+// hasRequestOptions reports whether a call carries any RequestOptions
+func hasRequestOptions(ctx context.Context, opts []RequestOption) bool {
+	return len(withContextOptions(ctx, opts)) > 0
+}
+
+func resolveRequestOptions(opts []RequestOption) requestOptions {
+	options := requestOptions{header: make(http.Header), query: make(url.Values)}
+	for _, opt := range opts {
+		opt(&options)
+	}
+	return options
+}
+
+// applyRequestOptions sets the headers and query parameters of opts on req
+// and returns the resolved options
+func applyRequestOptions(req *http.Request, opts []RequestOption) requestOptions {
+	if len(opts) == 0 {
+		return requestOptions{}
+	}
+
+	options := resolveRequestOptions(opts)
+	for key, values := range options.header {
+		req.Header[key] = values
+	}
+	if len(options.query) > 0 {
+		q := req.URL.Query()
+		for key, values := range options.query {
+			q[key] = values
+		}
+		req.URL.RawQuery = q.Encode()
+	}
+	return options
+}
+
+// withIdempotencyKey adds a random Idempotency-Key to opts unless the call
+// already carries one, so retries of the call cannot apply it twice
+func withIdempotencyKey(ctx context.Context, opts []RequestOption) ([]RequestOption, error) {
+	if resolveRequestOptions(withContextOptions(ctx, opts)).header.Get("Idempotency-Key") != "" {
+		return opts, nil
+	}
+
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return nil, fmt.Errorf("failed to generate idempotency key: %w", err)
+	}
+	return append([]RequestOption{IdempotencyKey(hex.EncodeToString(b))}, opts...), nil
+}
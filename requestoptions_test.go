@@ -0,0 +1,131 @@
+package vortex
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestRequestOptions(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Correlation-ID") != "corr-1" {
+			t.Errorf("Expected correlation header corr-1, got %q", r.Header.Get("X-Correlation-ID"))
+		}
+		if r.Header.Get("Idempotency-Key") != "key-1" {
+			t.Errorf("Expected idempotency key key-1, got %q", r.Header.Get("Idempotency-Key"))
+		}
+		if r.URL.Query().Get("beta") != "true" {
+			t.Errorf("Expected beta query param, got %s", r.URL.RawQuery)
+		}
+		json.NewEncoder(w).Encode(InvitationResult{ID: "inv1"})
+	}))
+	defer server.Close()
+
+	client := NewClientWithOptions("test-api-key", server.URL, nil)
+	_, err := client.ReinviteContext(context.Background(), "inv1",
+		Header("X-Correlation-ID", "corr-1"),
+		IdempotencyKey("key-1"),
+		QueryParam("beta", "true"),
+	)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+}
+
+func TestWithRequestOptions(t *testing.T) {
+	var headers []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		headers = append(headers, r.Header.Get("X-Correlation-ID"))
+		if r.URL.Query().Get("targetValue") != "a@example.com" {
+			t.Errorf("Expected the call's own query params to be kept, got %s", r.URL.RawQuery)
+		}
+		json.NewEncoder(w).Encode(InvitationsResponse{})
+	}))
+	defer server.Close()
+
+	client := NewClientWithOptions("test-api-key", server.URL, nil)
+	ctx := WithRequestOptions(context.Background(), Header("X-Correlation-ID", "from-ctx"))
+
+	client.GetInvitationsByTargetContext(ctx, "email", "a@example.com", IncludeArchived())
+	client.GetInvitationsByTargetContext(WithRequestOptions(ctx, Header("X-Correlation-ID", "nested")), "email", "a@example.com")
+
+	if len(headers) != 2 || headers[0] != "from-ctx" || headers[1] != "nested" {
+		t.Errorf("Expected [from-ctx nested], got %v", headers)
+	}
+}
+
+func TestRequestOptionsSkipCache(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		json.NewEncoder(w).Encode(InvitationResult{ID: "inv1", Locale: r.Header.Get("Accept-Language")})
+	}))
+	defer server.Close()
+
+	client := NewClientWithOptions("test-api-key", server.URL, nil, WithCache(NewLRUCache(10, time.Minute)))
+	ctx := context.Background()
+
+	client.GetInvitationContext(ctx, "inv1")
+	result, err := client.GetInvitationContext(ctx, "inv1", Locale("de"))
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if result.Locale != "de" {
+		t.Errorf("Expected uncached German result, got %q", result.Locale)
+	}
+	if requests != 2 {
+		t.Errorf("Expected 2 requests, got %d", requests)
+	}
+}
+
+func TestAutomaticIdempotencyKey(t *testing.T) {
+	var keys []string
+	attempts := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		keys = append(keys, r.Header.Get("Idempotency-Key"))
+		attempts++
+		if attempts == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		json.NewEncoder(w).Encode(InvitationResult{ID: "inv1"})
+	}))
+	defer server.Close()
+
+	client := NewClientWithOptions("test-api-key", server.URL, nil, WithRetry(2, time.Millisecond))
+	if _, err := client.ReinviteContext(context.Background(), "inv1"); err != nil {
+		t.Fatalf("Expected the retry to succeed, got %v", err)
+	}
+
+	if len(keys) != 2 || keys[0] == "" || keys[0] != keys[1] {
+		t.Errorf("Expected one generated key sent on both attempts, got %v", keys)
+	}
+
+	keys = nil
+	client.ReinviteContext(context.Background(), "inv1")
+	client.AcceptInvitations([]string{"inv1"}, InvitationTarget{Type: "email", Value: "a@example.com"})
+	client.CreateInvitation(context.Background(), CreateInvitationRequest{})
+	if len(keys) != 3 || keys[0] == "" || keys[1] == "" || keys[2] == "" || keys[0] == keys[1] || keys[1] == keys[2] {
+		t.Errorf("Expected a fresh key per call, got %v", keys)
+	}
+}
+
+func TestExplicitIdempotencyKey(t *testing.T) {
+	var keys []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		keys = append(keys, r.Header.Get("Idempotency-Key"))
+		json.NewEncoder(w).Encode(InvitationResult{ID: "inv1"})
+	}))
+	defer server.Close()
+
+	client := NewClientWithOptions("test-api-key", server.URL, nil)
+	client.ReinviteContext(context.Background(), "inv1", IdempotencyKey("job-42"))
+	client.ReinviteContext(WithRequestOptions(context.Background(), IdempotencyKey("from-ctx")), "inv1")
+
+	if len(keys) != 2 || keys[0] != "job-42" || keys[1] != "from-ctx" {
+		t.Errorf("Expected the given keys, got %v", keys)
+	}
+}
@@ -0,0 +1,78 @@
+package vortex
+
+import (
+	"net/http"
+	"sync"
+	"time"
+)
+
+// ResponseMetadata describes an API response beyond its body, e.g. the
+// request ID to quote when contacting Vortex support
+type ResponseMetadata struct {
+	StatusCode int
+	// RequestID is the X-Request-Id Vortex assigned to the request
+	RequestID string
+	// RateLimit is nil when the response carried no X-RateLimit headers
+	RateLimit *RateLimit
+	// Duration is how long the request took, from sending it to reading the
+	// response body; for retried calls it covers the last attempt only
+	Duration time.Duration
+	Header   http.Header
+}
+
+// CaptureResponse stores the metadata of the call's response in meta, which
+// is left unchanged if no response arrived
+//
+// Unlike LastResponse, it is unaffected by concurrent calls on the client.
+//
+// Example:
+//
+//	var meta vortex.ResponseMetadata
+//	_, err := client.Reinvite(invitationID, vortex.CaptureResponse(&meta))
+//	if err != nil {
+//	    log.Printf("reinvite failed (request %s): %v", meta.RequestID, err)
+//	}
+func CaptureResponse(meta *ResponseMetadata) RequestOption {
+	return func(o *requestOptions) {
+		o.capture = meta
+	}
+}
+
+// responseState holds the most recent ResponseMetadata seen by a client and
+// the clients derived from it with AsUser
+type responseState struct {
+	mu   sync.Mutex
+	last *ResponseMetadata
+}
+
+// LastResponse returns the metadata of the most recent response, error
+// responses included, or nil if no response has arrived yet
+//
+// With concurrent calls it may belong to a different call; use
+// CaptureResponse to get the metadata of a particular one.
+func (c *Client) LastResponse() *ResponseMetadata {
+	if c.responses == nil {
+		return nil
+	}
+	c.responses.mu.Lock()
+	defer c.responses.mu.Unlock()
+	if c.responses.last == nil {
+		return nil
+	}
+	meta := *c.responses.last
+	return &meta
+}
+
+// recordResponse makes meta the client's LastResponse and stores it in the
+// call's CaptureResponse target
+func (c *Client) recordResponse(meta *ResponseMetadata, options requestOptions) {
+	if options.capture != nil {
+		*options.capture = *meta
+	}
+	if c.responses == nil {
+		return
+	}
+	c.responses.mu.Lock()
+	c.responses.last = meta
+	c.responses.mu.Unlock()
+}
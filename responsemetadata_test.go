@@ -0,0 +1,55 @@
+package vortex
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestCaptureResponse(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Request-Id", "req-"+r.URL.Path[len("/api/v1/invitations/"):])
+		w.Header().Set("X-RateLimit-Remaining", "41")
+		if r.URL.Path == "/api/v1/invitations/missing" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Write([]byte(`{"id": "inv-1"}`))
+	}))
+	defer server.Close()
+
+	client := NewClientWithOptions("test-api-key", server.URL, nil)
+
+	if client.LastResponse() != nil {
+		t.Error("Expected no LastResponse before any call")
+	}
+
+	var meta ResponseMetadata
+	if _, err := client.GetInvitationContext(context.Background(), "inv-1", CaptureResponse(&meta)); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if meta.StatusCode != 200 || meta.RequestID != "req-inv-1" {
+		t.Errorf("Expected status 200 and req-inv-1, got %d %s", meta.StatusCode, meta.RequestID)
+	}
+	if meta.RateLimit == nil || meta.RateLimit.Remaining != 41 {
+		t.Errorf("Expected 41 calls remaining, got %+v", meta.RateLimit)
+	}
+	if meta.Duration <= 0 {
+		t.Errorf("Expected a positive duration, got %v", meta.Duration)
+	}
+
+	_, err := client.GetInvitationContext(context.Background(), "missing", CaptureResponse(&meta))
+	if !errors.Is(err, ErrNotFound) {
+		t.Fatalf("Expected ErrNotFound, got %v", err)
+	}
+	if meta.StatusCode != 404 || meta.RequestID != "req-missing" {
+		t.Errorf("Expected status 404 and req-missing, got %d %s", meta.StatusCode, meta.RequestID)
+	}
+
+	last := client.AsUser(&User{ID: "user-1"}).LastResponse()
+	if last == nil || last.RequestID != "req-missing" {
+		t.Errorf("Expected LastResponse to be shared with AsUser clients, got %+v", last)
+	}
+}
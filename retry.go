@@ -0,0 +1,136 @@
+package vortex
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// DefaultMaxRetryDelay caps the wait between attempts when
+// RetryPolicy.MaxDelay is not set
+const DefaultMaxRetryDelay = 30 * time.Second
+
+// RetryPolicy controls how requests that fail with a 429, a 5xx or a network
+// error are retried
+//
+// Only GET, HEAD and DELETE requests, and requests carrying an Idempotency-Key
+// header, are retried unless RetryMutations is set, since repeating a POST,
+// PUT or PATCH whose response was lost could apply it twice.
+type RetryPolicy struct {
+	// MaxAttempts is the total number of attempts, including the first
+	MaxAttempts int
+	// BaseDelay is the wait before the first retry; it doubles on each retry
+	// and is jittered
+	BaseDelay time.Duration
+	// MaxDelay caps the wait between attempts. A Retry-After longer than this
+	// ends the retries instead.
+	MaxDelay time.Duration
+	// RetryMutations also retries POST, PUT and PATCH requests
+	RetryMutations bool
+}
+
+// WithRetry retries idempotent requests up to maxAttempts times in total,
+// waiting baseDelay before the first retry and backing off exponentially
+//
+// Example:
+//
+//	client := vortex.NewClient(apiKey, vortex.WithRetry(4, 200*time.Millisecond))
+func WithRetry(maxAttempts int, baseDelay time.Duration) ClientOption {
+	return WithRetryPolicy(RetryPolicy{MaxAttempts: maxAttempts, BaseDelay: baseDelay})
+}
+
+// WithRetryPolicy retries requests as described by policy
+func WithRetryPolicy(policy RetryPolicy) ClientOption {
+	return func(c *Client) {
+		if policy.MaxAttempts > 1 {
+			c.retry = &policy
+		} else {
+			c.retry = nil
+		}
+	}
+}
+
+// jitter returns a random duration in [0, n); replaced in tests
+var jitter = func(n time.Duration) time.Duration {
+	if n <= 0 {
+		return 0
+	}
+	return time.Duration(rand.Int63n(int64(n)))
+}
+
+// allows reports whether the policy applies to a request
+func (p *RetryPolicy) allows(method string, opts []RequestOption) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodDelete:
+		return true
+	}
+	if p.RetryMutations {
+		return true
+	}
+	return resolveRequestOptions(opts).header.Get("Idempotency-Key") != ""
+}
+
+// delay returns the wait before the retry following attempt, and false if the
+// server asked for a longer wait than the policy allows
+func (p *RetryPolicy) delay(attempt int, err error) (time.Duration, bool) {
+	maxDelay := p.MaxDelay
+	if maxDelay <= 0 {
+		maxDelay = DefaultMaxRetryDelay
+	}
+
+	var apiErr *APIError
+	if errors.As(err, &apiErr) && apiErr.RetryAfter > 0 {
+		return apiErr.RetryAfter, apiErr.RetryAfter <= maxDelay
+	}
+
+	backoff := p.BaseDelay
+	for i := 1; i < attempt && backoff < maxDelay; i++ {
+		backoff *= 2
+	}
+	if backoff > maxDelay {
+		backoff = maxDelay
+	}
+	// Wait between half and all of the backoff, so clients that failed
+	// together do not retry together
+	return backoff/2 + jitter(backoff/2+1), true
+}
+
+// retryable reports whether err is a transient failure worth retrying
+func (c *Client) retryable(ctx context.Context, err error) bool {
+	if ctx.Err() != nil {
+		return false
+	}
+
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		return apiErr.StatusCode == http.StatusTooManyRequests || apiErr.StatusCode >= 500
+	}
+
+	// The HTTP client reports connection failures and timeouts as *url.Error
+	var urlErr *url.Error
+	return errors.As(err, &urlErr)
+}
+
+// parseRetryAfter reads a Retry-After header given in seconds or as an HTTP
+// date
+func parseRetryAfter(value string, now time.Time) time.Duration {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return 0
+	}
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0
+		}
+		return time.Duration(seconds) * time.Second
+	}
+	if t, err := http.ParseTime(value); err == nil && t.After(now) {
+		return t.Sub(now)
+	}
+	return 0
+}
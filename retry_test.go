@@ -0,0 +1,190 @@
+package vortex
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestWithRetry(t *testing.T) {
+	var requests int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&requests, 1) < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Write([]byte(`{"id": "inv-123"}`))
+	}))
+	defer server.Close()
+
+	client := NewClientWithOptions("test-api-key", server.URL, nil, WithRetry(3, time.Millisecond))
+
+	invitation, err := client.GetInvitation("inv-123")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if invitation.ID != "inv-123" {
+		t.Errorf("Expected invitation inv-123, got %s", invitation.ID)
+	}
+	if requests != 3 {
+		t.Errorf("Expected 3 requests, got %d", requests)
+	}
+}
+
+func TestWithRetry_GivesUp(t *testing.T) {
+	var requests int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer server.Close()
+
+	client := NewClientWithOptions("test-api-key", server.URL, nil, WithRetry(2, time.Millisecond))
+
+	_, err := client.GetInvitation("inv-123")
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusBadGateway {
+		t.Errorf("Expected 502 APIError, got %v", err)
+	}
+	if requests != 2 {
+		t.Errorf("Expected 2 requests, got %d", requests)
+	}
+}
+
+func TestWithRetry_Mutations(t *testing.T) {
+	var requests int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	client := NewClientWithOptions("test-api-key", server.URL, nil, WithRetry(3, time.Millisecond))
+	if _, err := client.ArchiveInvitation(context.Background(), "inv-123"); err == nil {
+		t.Fatal("Expected error")
+	}
+	if requests != 1 {
+		t.Errorf("Expected POST not to be retried, got %d requests", requests)
+	}
+
+	atomic.StoreInt32(&requests, 0)
+	client = NewClientWithOptions("test-api-key", server.URL, nil, WithRetryPolicy(RetryPolicy{
+		MaxAttempts:    3,
+		BaseDelay:      time.Millisecond,
+		RetryMutations: true,
+	}))
+	if _, err := client.ArchiveInvitation(context.Background(), "inv-123"); err == nil {
+		t.Fatal("Expected error")
+	}
+	if requests != 3 {
+		t.Errorf("Expected POST to be retried with RetryMutations, got %d requests", requests)
+	}
+}
+
+func TestWithRetry_ClientErrors(t *testing.T) {
+	var requests int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	client := NewClientWithOptions("test-api-key", server.URL, nil, WithRetry(3, time.Millisecond))
+	if _, err := client.GetInvitation("inv-123"); err == nil {
+		t.Fatal("Expected error")
+	}
+	if requests != 1 {
+		t.Errorf("Expected a 404 not to be retried, got %d requests", requests)
+	}
+}
+
+func TestWithRetry_NetworkError(t *testing.T) {
+	var requests int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&requests, 1) == 1 {
+			conn, _, _ := w.(http.Hijacker).Hijack()
+			conn.Close()
+			return
+		}
+		w.Write([]byte(`{"id": "inv-123"}`))
+	}))
+	defer server.Close()
+
+	client := NewClientWithOptions("test-api-key", server.URL, nil, WithRetry(2, time.Millisecond))
+	if _, err := client.GetInvitation("inv-123"); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if requests != 2 {
+		t.Errorf("Expected 2 requests, got %d", requests)
+	}
+}
+
+func TestWithRetry_ContextCancelled(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Retry-After", "10")
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer server.Close()
+
+	client := NewClientWithOptions("test-api-key", server.URL, nil, WithRetry(3, time.Millisecond))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	_, err := client.GetInvitationContext(ctx, "inv-123")
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) || apiErr.RetryAfter != 10*time.Second {
+		t.Errorf("Expected 429 APIError with RetryAfter 10s, got %v", err)
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("Expected the wait to end with the context, took %s", elapsed)
+	}
+}
+
+func TestRetryPolicyDelay(t *testing.T) {
+	defer func(original func(time.Duration) time.Duration) { jitter = original }(jitter)
+	jitter = func(n time.Duration) time.Duration { return 0 }
+
+	policy := RetryPolicy{MaxAttempts: 5, BaseDelay: 100 * time.Millisecond, MaxDelay: time.Second}
+	transient := &APIError{StatusCode: http.StatusServiceUnavailable}
+
+	for attempt, want := range map[int]time.Duration{
+		1: 50 * time.Millisecond,
+		2: 100 * time.Millisecond,
+		3: 200 * time.Millisecond,
+		6: 500 * time.Millisecond,
+	} {
+		if got, ok := policy.delay(attempt, transient); !ok || got != want {
+			t.Errorf("Attempt %d: expected delay %s, got %s", attempt, want, got)
+		}
+	}
+
+	if got, ok := policy.delay(1, &APIError{StatusCode: 429, RetryAfter: 700 * time.Millisecond}); !ok || got != 700*time.Millisecond {
+		t.Errorf("Expected Retry-After to be honored, got %s", got)
+	}
+	if _, ok := policy.delay(1, &APIError{StatusCode: 429, RetryAfter: time.Minute}); ok {
+		t.Error("Expected a Retry-After over MaxDelay to stop retrying")
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	tests := map[string]time.Duration{
+		"":                              0,
+		"5":                             5 * time.Second,
+		"-1":                            0,
+		"Thu, 01 Jan 2026 12:00:30 GMT": 30 * time.Second,
+		"Thu, 01 Jan 2026 11:00:00 GMT": 0,
+		"soon":                          0,
+	}
+	for value, want := range tests {
+		if got := parseRetryAfter(value, now); got != want {
+			t.Errorf("parseRetryAfter(%q): expected %s, got %s", value, want, got)
+		}
+	}
+}
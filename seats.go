@@ -0,0 +1,50 @@
+package vortex
+
+import (
+	"context"
+	"fmt"
+)
+
+// SeatUsage is how many of a group's seats are taken
+type SeatUsage struct {
+	Group GroupRef `json:"group"`
+	// SeatLimit is the maximum number of members, nil when unlimited
+	SeatLimit *int `json:"seatLimit,omitempty"`
+	// SeatsUsed counts accepted members
+	SeatsUsed int `json:"seatsUsed"`
+	// PendingInvitations counts invitations that would take a seat if accepted
+	PendingInvitations int `json:"pendingInvitations"`
+}
+
+// CanInvite reports whether n more invitations fit in the group's seat limit,
+// counting pending invitations as taken seats
+func (u *SeatUsage) CanInvite(n int) bool {
+	if u.SeatLimit == nil {
+		return true
+	}
+	return u.SeatsUsed+u.PendingInvitations+n <= *u.SeatLimit
+}
+
+// GetSeatUsage retrieves a group's seat limit and usage
+//
+// Example:
+//
+//	usage, err := client.GetSeatUsage(ctx, vortex.GroupRef{Type: "workspace", GroupID: "ws-123"})
+//	if err == nil && !usage.CanInvite(len(emails)) {
+//	    return errors.New("not enough seats left in this workspace")
+//	}
+func (c *Client) GetSeatUsage(ctx context.Context, group GroupRef, opts ...RequestOption) (*SeatUsage, error) {
+	path := fmt.Sprintf("/api/v1/groups/%s/%s/seats", group.Type, group.GroupID)
+
+	responseBody, err := c.apiRequestContext(ctx, "GET", path, nil, nil, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	var usage SeatUsage
+	if err := c.decode(responseBody, &usage); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	return &usage, nil
+}
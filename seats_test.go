@@ -0,0 +1,41 @@
+package vortex
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestGetSeatUsage(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "GET" || r.URL.Path != "/api/v1/groups/workspace/ws-123/seats" {
+			t.Errorf("Expected GET /api/v1/groups/workspace/ws-123/seats, got %s %s", r.Method, r.URL.Path)
+		}
+		w.Write([]byte(`{
+			"group": {"type": "workspace", "groupId": "ws-123"},
+			"seatLimit": 10,
+			"seatsUsed": 7,
+			"pendingInvitations": 2
+		}`))
+	}))
+	defer server.Close()
+
+	client := NewClientWithOptions("test-api-key", server.URL, nil)
+
+	usage, err := client.GetSeatUsage(context.Background(), GroupRef{Type: "workspace", GroupID: "ws-123"})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if !usage.CanInvite(1) {
+		t.Error("Expected room for 1 more invitation")
+	}
+	if usage.CanInvite(2) {
+		t.Error("Expected no room for 2 more invitations")
+	}
+
+	usage.SeatLimit = nil
+	if !usage.CanInvite(1000) {
+		t.Error("Expected unlimited groups to always have room")
+	}
+}
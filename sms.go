@@ -0,0 +1,52 @@
+package vortex
+
+import (
+	"context"
+	"fmt"
+)
+
+// SMSSettings configures SMS delivery for invitations with phone number targets
+type SMSSettings struct {
+	// SenderID is the alphanumeric sender ID or number messages are sent from
+	SenderID string `json:"senderId,omitempty"`
+	// AllowedCountries lists the ISO 3166-1 alpha-2 codes invitations may be
+	// sent to; empty allows every supported country
+	AllowedCountries []string `json:"allowedCountries"`
+}
+
+// GetSMSSettings retrieves the project's SMS delivery settings
+func (c *Client) GetSMSSettings(ctx context.Context, opts ...RequestOption) (*SMSSettings, error) {
+	responseBody, err := c.apiRequestContext(ctx, "GET", "/api/v1/settings/sms", nil, nil, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	var settings SMSSettings
+	if err := c.decode(responseBody, &settings); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	return &settings, nil
+}
+
+// UpdateSMSSettings replaces the project's SMS delivery settings
+//
+// Example:
+//
+//	settings, err := client.UpdateSMSSettings(ctx, vortex.SMSSettings{
+//	    SenderID:         "ACME",
+//	    AllowedCountries: []string{"US", "CA", "GB"},
+//	})
+func (c *Client) UpdateSMSSettings(ctx context.Context, settings SMSSettings, opts ...RequestOption) (*SMSSettings, error) {
+	responseBody, err := c.apiRequestContext(ctx, "PUT", "/api/v1/settings/sms", settings, nil, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	var updated SMSSettings
+	if err := c.decode(responseBody, &updated); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	return &updated, nil
+}
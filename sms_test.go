@@ -0,0 +1,45 @@
+package vortex
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestSMSSettings(t *testing.T) {
+	stored := SMSSettings{SenderID: "VORTEX", AllowedCountries: []string{"US"}}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v1/settings/sms" {
+			t.Errorf("Expected path /api/v1/settings/sms, got %s", r.URL.Path)
+		}
+		if r.Method == "PUT" {
+			if err := json.NewDecoder(r.Body).Decode(&stored); err != nil {
+				t.Errorf("Failed to decode request body: %v", err)
+			}
+		}
+		json.NewEncoder(w).Encode(stored)
+	}))
+	defer server.Close()
+
+	client := NewClientWithOptions("test-api-key", server.URL, nil)
+	ctx := context.Background()
+
+	settings, err := client.GetSMSSettings(ctx)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if settings.SenderID != "VORTEX" {
+		t.Errorf("Expected sender ID 'VORTEX', got %s", settings.SenderID)
+	}
+
+	settings, err = client.UpdateSMSSettings(ctx, SMSSettings{SenderID: "ACME", AllowedCountries: []string{"US", "CA"}})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if settings.SenderID != "ACME" || len(settings.AllowedCountries) != 2 {
+		t.Errorf("Expected updated settings, got %+v", settings)
+	}
+}
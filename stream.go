@@ -0,0 +1,255 @@
+package vortex
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const streamPath = "/api/v1/events/stream"
+
+// Reconnection delays of StreamEvents; the server can change the initial
+// delay with the stream's retry field
+const (
+	StreamRetryDelay    = time.Second
+	MaxStreamRetryDelay = 30 * time.Second
+)
+
+// maxStreamEventSize bounds a single event read from a stream
+const maxStreamEventSize = 1 << 20
+
+// StreamOptions selects the events StreamEvents delivers
+type StreamOptions struct {
+	// Types limits the stream to the given event types, e.g.
+	// EventInvitationAccepted; all events are streamed when empty
+	Types []string
+	// ResumeFrom is the ID of the last event handled, to continue an earlier
+	// stream after it rather than from now
+	ResumeFrom string
+	// OnError is called with each error that interrupts the stream, before
+	// it reconnects
+	OnError func(err error)
+}
+
+// StreamEvents streams events as they happen over a server-sent events
+// connection, instead of polling for changes
+//
+// The connection is opened before StreamEvents returns, so an invalid API key
+// fails right away. After that, dropped connections are reopened with
+// backoff, resuming after the last event received so none are missed. The
+// channel is closed once ctx is done, or when reconnecting fails with an
+// error that retrying cannot fix, such as a revoked API key.
+//
+// Streams are not subject to the client's timeout, middleware or metrics.
+//
+// Example:
+//
+//	events, err := client.StreamEvents(ctx, vortex.StreamOptions{
+//	    Types: []string{vortex.EventInvitationAccepted},
+//	})
+//	if err != nil {
+//	    return err
+//	}
+//	for event := range events {
+//	    accepted := event.Data.(*vortex.InvitationEvent)
+//	    // ...
+//	}
+func (c *Client) StreamEvents(ctx context.Context, opts StreamOptions) (<-chan Event, error) {
+	// A stream stays open indefinitely, so it must not inherit the timeout
+	httpClient := *c.httpClient
+	httpClient.Timeout = 0
+	s := &eventStream{
+		client:     c,
+		httpClient: &httpClient,
+		opts:       opts,
+		lastID:     opts.ResumeFrom,
+		retry:      StreamRetryDelay,
+	}
+
+	body, err := s.connect(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	events := make(chan Event)
+	go s.run(ctx, body, events)
+	return events, nil
+}
+
+// eventStream is the state of a StreamEvents stream across reconnections
+type eventStream struct {
+	client     *Client
+	httpClient *http.Client
+	opts       StreamOptions
+
+	// lastID is the ID of the last event received, sent as Last-Event-ID
+	lastID string
+	// retry is the delay before reconnecting
+	retry time.Duration
+}
+
+// run delivers the events read from body, reconnecting until ctx is done
+func (s *eventStream) run(ctx context.Context, body io.ReadCloser, events chan<- Event) {
+	defer close(events)
+
+	for {
+		err := s.read(ctx, body, events)
+		body.Close()
+		if ctx.Err() != nil {
+			return
+		}
+		if err != nil {
+			s.report(err)
+		}
+
+		delay := s.retry
+		for {
+			timer := time.NewTimer(delay)
+			select {
+			case <-ctx.Done():
+				timer.Stop()
+				return
+			case <-timer.C:
+			}
+
+			body, err = s.connect(ctx)
+			if err == nil {
+				break
+			}
+			if ctx.Err() != nil {
+				return
+			}
+			s.report(err)
+			if !s.client.retryable(ctx, err) {
+				return
+			}
+			if delay *= 2; delay > MaxStreamRetryDelay {
+				delay = MaxStreamRetryDelay
+			}
+		}
+	}
+}
+
+// connect opens the stream, resuming after lastID
+func (s *eventStream) connect(ctx context.Context) (io.ReadCloser, error) {
+	c := s.client
+	req, err := http.NewRequestWithContext(ctx, "GET", c.baseURL+streamPath, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	if len(s.opts.Types) > 0 {
+		q := req.URL.Query()
+		q.Set("types", strings.Join(s.opts.Types, ","))
+		req.URL.RawQuery = q.Encode()
+	}
+	if err := c.setHeaders(req); err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "text/event-stream")
+	req.Header.Set("Cache-Control", "no-cache")
+	if s.lastID != "" {
+		req.Header.Set("Last-Event-ID", s.lastID)
+	}
+	applyRequestOptions(req, withContextOptions(ctx, nil))
+
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("request failed: %w", err)
+	}
+	if resp.StatusCode >= 400 {
+		defer resp.Body.Close()
+		details, _ := io.ReadAll(io.LimitReader(resp.Body, maxStreamEventSize))
+		return nil, &APIError{
+			StatusCode: resp.StatusCode,
+			Message:    fmt.Sprintf("Vortex API request failed: %d %s", resp.StatusCode, resp.Status),
+			Details:    string(details),
+			Method:     "GET",
+			Path:       streamPath,
+			RequestID:  resp.Header.Get("X-Request-Id"),
+			RetryAfter: parseRetryAfter(resp.Header.Get("Retry-After"), time.Now()),
+		}
+	}
+	return resp.Body, nil
+}
+
+// read delivers the events in body until it ends, returning nil when the
+// server closed the stream
+func (s *eventStream) read(ctx context.Context, body io.Reader, events chan<- Event) error {
+	scanner := bufio.NewScanner(body)
+	scanner.Buffer(make([]byte, 0, 4096), maxStreamEventSize)
+
+	var id string
+	var data strings.Builder
+	for scanner.Scan() {
+		line := scanner.Text()
+		if line == "" {
+			// A blank line ends the event
+			if data.Len() > 0 {
+				if err := s.dispatch(ctx, id, data.String(), events); err != nil {
+					return err
+				}
+			}
+			id = s.lastID
+			data.Reset()
+			continue
+		}
+
+		field, value, _ := strings.Cut(line, ":")
+		value = strings.TrimPrefix(value, " ")
+		switch field {
+		case "id":
+			id = value
+		case "data":
+			if data.Len() > 0 {
+				data.WriteByte('\n')
+			}
+			data.WriteString(value)
+		case "retry":
+			if ms, err := strconv.Atoi(value); err == nil && ms > 0 {
+				s.retry = time.Duration(ms) * time.Millisecond
+			}
+		}
+		// Lines starting with ":" are keep-alive comments; "event" repeats
+		// the type carried in the data
+	}
+	if err := scanner.Err(); err != nil {
+		return fmt.Errorf("event stream interrupted: %w", err)
+	}
+	return nil
+}
+
+// dispatch parses an event and sends it, returning ctx's error once it is done
+func (s *eventStream) dispatch(ctx context.Context, id, data string, events chan<- Event) error {
+	event, err := ParseEvent([]byte(data))
+	if err != nil {
+		s.report(err)
+		return nil
+	}
+	if event.ID == "" {
+		event.ID = id
+	}
+
+	select {
+	case events <- event:
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+	if event.ID != "" {
+		s.lastID = event.ID
+	}
+	return nil
+}
+
+func (s *eventStream) report(err error) {
+	if s.opts.OnError != nil {
+		s.opts.OnError(err)
+	}
+	if s.client.logger != nil {
+		s.client.logger.Warn("vortex event stream interrupted", "error", err)
+	}
+}
@@ -0,0 +1,96 @@
+package vortex
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestStreamEvents(t *testing.T) {
+	var mu sync.Mutex
+	var lastEventIDs []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v1/events/stream" {
+			t.Errorf("Expected path /api/v1/events/stream, got %s", r.URL.Path)
+		}
+		if r.Header.Get("Accept") != "text/event-stream" {
+			t.Errorf("Expected Accept text/event-stream, got %s", r.Header.Get("Accept"))
+		}
+		if r.URL.Query().Get("types") != "invitation.accepted,custom&type=x" {
+			t.Errorf("Expected types filter, got %s", r.URL.RawQuery)
+		}
+
+		mu.Lock()
+		lastEventIDs = append(lastEventIDs, r.Header.Get("Last-Event-ID"))
+		connection := len(lastEventIDs)
+		mu.Unlock()
+
+		w.Header().Set("Content-Type", "text/event-stream")
+		fmt.Fprint(w, "retry: 10\n: keep-alive\n\n")
+		fmt.Fprintf(w, "id: evt-%d\nevent: invitation.accepted\n", connection)
+		fmt.Fprintf(w, "data: {\"type\": \"invitation.accepted\",\ndata: \"data\": {\"invitation\": {\"id\": \"inv-%d\"}}}\n\n", connection)
+		// Closing the response drops the connection, so the client reconnects
+	}))
+	defer server.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	client := NewClientWithOptions("test-api-key", server.URL, nil)
+	events, err := client.StreamEvents(ctx, StreamOptions{
+		Types:      []string{EventInvitationAccepted, "custom&type=x"},
+		ResumeFrom: "evt-0",
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	for i := 1; i <= 2; i++ {
+		select {
+		case event := <-events:
+			if event.ID != fmt.Sprintf("evt-%d", i) {
+				t.Errorf("Expected evt-%d, got %s", i, event.ID)
+			}
+			data, ok := event.Data.(*InvitationEvent)
+			if !ok || data.Invitation.ID != fmt.Sprintf("inv-%d", i) {
+				t.Errorf("Expected inv-%d, got %+v", i, event.Data)
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatalf("Timed out waiting for event %d", i)
+		}
+	}
+
+	cancel()
+	for range events {
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	if lastEventIDs[0] != "evt-0" || lastEventIDs[1] != "evt-1" {
+		t.Errorf("Expected to resume from evt-0 then evt-1, got %v", lastEventIDs)
+	}
+}
+
+func TestStreamEvents_Unauthorized(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+		w.Write([]byte(`{"error": "invalid API key"}`))
+	}))
+	defer server.Close()
+
+	client := NewClientWithOptions("test-api-key", server.URL, nil)
+	_, err := client.StreamEvents(context.Background(), StreamOptions{})
+
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusUnauthorized {
+		t.Fatalf("Expected a 401 APIError, got %v", err)
+	}
+	if apiErr.Path != "/api/v1/events/stream" {
+		t.Errorf("Expected path /api/v1/events/stream, got %s", apiErr.Path)
+	}
+}
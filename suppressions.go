@@ -0,0 +1,57 @@
+package vortex
+
+import (
+	"context"
+)
+
+// SuppressTarget stops all future invitations to an email address or phone
+// number; reason is recorded for support staff
+func (c *Client) SuppressTarget(ctx context.Context, target InvitationTarget, reason string, opts ...RequestOption) error {
+	requestBody := map[string]interface{}{
+		"target": target,
+		"reason": reason,
+	}
+
+	_, err := c.apiRequestContext(ctx, "POST", "/api/v1/suppressions", requestBody, nil, opts...)
+	return err
+}
+
+// RemoveSuppression allows invitations to a suppressed target again, e.g.
+// after the recipient fixed their mailbox
+func (c *Client) RemoveSuppression(ctx context.Context, target InvitationTarget, opts ...RequestOption) error {
+	queryParams := map[string]string{
+		"targetType":  string(target.Type),
+		"targetValue": target.Value,
+	}
+
+	_, err := c.apiRequestContext(ctx, "DELETE", "/api/v1/suppressions", nil, queryParams, opts...)
+	return err
+}
+
+// SuppressHardBounce suppresses the target of a hard bounce, so invitations
+// are not sent to an address that cannot receive them
+//
+// Soft bounces are ignored. It reports whether the target was suppressed.
+//
+// Example:
+//
+//	case vortex.EventDeliveryBounced:
+//	    var bounce vortex.DeliveryBouncedEvent
+//	    if err := json.Unmarshal(data, &bounce); err != nil {
+//	        return err
+//	    }
+//	    _, err := client.SuppressHardBounce(ctx, bounce)
+func (c *Client) SuppressHardBounce(ctx context.Context, event DeliveryBouncedEvent, opts ...RequestOption) (bool, error) {
+	if !event.Hard() {
+		return false, nil
+	}
+
+	reason := "hard bounce"
+	if event.Reason != "" {
+		reason += ": " + event.Reason
+	}
+	if err := c.SuppressTarget(ctx, event.Target, reason, opts...); err != nil {
+		return false, err
+	}
+	return true, nil
+}
@@ -0,0 +1,73 @@
+package vortex
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestSuppressHardBounce(t *testing.T) {
+	var suppressed []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "POST" || r.URL.Path != "/api/v1/suppressions" {
+			t.Errorf("Expected POST /api/v1/suppressions, got %s %s", r.Method, r.URL.Path)
+		}
+
+		var req struct {
+			Target InvitationTarget `json:"target"`
+			Reason string           `json:"reason"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Errorf("Failed to decode request body: %v", err)
+		}
+		if req.Reason != "hard bounce: 550 user unknown" {
+			t.Errorf("Expected bounce reason to be recorded, got '%s'", req.Reason)
+		}
+		suppressed = append(suppressed, req.Target.Value)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	client := NewClientWithOptions("test-api-key", server.URL, nil)
+	ctx := context.Background()
+
+	var soft DeliveryBouncedEvent
+	json.Unmarshal([]byte(`{"invitationId": "inv1", "target": {"type": "email", "value": "full@example.com"}, "bounceType": "soft"}`), &soft)
+	ok, err := client.SuppressHardBounce(ctx, soft)
+	if err != nil || ok {
+		t.Errorf("Expected soft bounce to be ignored, got %v, %v", ok, err)
+	}
+
+	var hard DeliveryBouncedEvent
+	json.Unmarshal([]byte(`{"invitationId": "inv1", "target": {"type": "email", "value": "gone@example.com"}, "bounceType": "hard", "reason": "550 user unknown"}`), &hard)
+	ok, err = client.SuppressHardBounce(ctx, hard)
+	if err != nil || !ok {
+		t.Errorf("Expected hard bounce to be suppressed, got %v, %v", ok, err)
+	}
+
+	if len(suppressed) != 1 || suppressed[0] != "gone@example.com" {
+		t.Errorf("Expected only 'gone@example.com' to be suppressed, got %v", suppressed)
+	}
+}
+
+func TestRemoveSuppression(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "DELETE" || r.URL.Path != "/api/v1/suppressions" {
+			t.Errorf("Expected DELETE /api/v1/suppressions, got %s %s", r.Method, r.URL.Path)
+		}
+		if r.URL.Query().Get("targetValue") != "+15551234567" {
+			t.Errorf("Expected targetValue '+15551234567', got '%s'", r.URL.Query().Get("targetValue"))
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	client := NewClientWithOptions("test-api-key", server.URL, nil)
+
+	err := client.RemoveSuppression(context.Background(), InvitationTarget{Type: "phoneNumber", Value: "+15551234567"})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+}
@@ -0,0 +1,56 @@
+package vortex
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// TaggedWith limits list calls to invitations carrying all of the given tags
+//
+// Example:
+//
+//	invitations, err := client.GetInvitationsByGroup("workspace", "ws-123", vortex.TaggedWith("spring-campaign"))
+func TaggedWith(tags ...string) ListOption {
+	return func(queryParams map[string]string) {
+		queryParams["tags"] = strings.Join(tags, ",")
+	}
+}
+
+// AddInvitationTags adds tags to an invitation, keeping the ones it already has
+func (c *Client) AddInvitationTags(ctx context.Context, invitationID string, tags ...string) (*InvitationResult, error) {
+	path := fmt.Sprintf("/api/v1/invitations/%s/tags", invitationID)
+
+	requestBody := map[string][]string{
+		"tags": tags,
+	}
+
+	return c.updateTags(ctx, invitationID, "POST", path, requestBody, nil)
+}
+
+// RemoveInvitationTags removes tags from an invitation
+func (c *Client) RemoveInvitationTags(ctx context.Context, invitationID string, tags ...string) (*InvitationResult, error) {
+	path := fmt.Sprintf("/api/v1/invitations/%s/tags", invitationID)
+
+	queryParams := map[string]string{
+		"tags": strings.Join(tags, ","),
+	}
+
+	return c.updateTags(ctx, invitationID, "DELETE", path, nil, queryParams)
+}
+
+func (c *Client) updateTags(ctx context.Context, invitationID, method, path string, body interface{}, queryParams map[string]string) (*InvitationResult, error) {
+	responseBody, err := c.apiRequestContext(ctx, method, path, body, queryParams)
+	if err != nil {
+		return nil, err
+	}
+
+	c.invalidateInvitation(invitationID)
+
+	var result InvitationResult
+	if err := c.decode(responseBody, &result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	return &result, nil
+}
@@ -0,0 +1,89 @@
+package vortex
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestInvitationTags(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v1/invitations/inv1/tags" {
+			t.Errorf("Expected path /api/v1/invitations/inv1/tags, got %s", r.URL.Path)
+		}
+
+		tags := []string{"beta", "spring"}
+		switch r.Method {
+		case "POST":
+			var req map[string][]string
+			if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+				t.Errorf("Failed to decode request body: %v", err)
+			}
+			if len(req["tags"]) != 1 || req["tags"][0] != "spring" {
+				t.Errorf("Expected tags [spring], got %v", req["tags"])
+			}
+		case "DELETE":
+			if r.URL.Query().Get("tags") != "beta,spring" {
+				t.Errorf("Expected tags query 'beta,spring', got %s", r.URL.Query().Get("tags"))
+			}
+			tags = nil
+		}
+
+		json.NewEncoder(w).Encode(InvitationResult{ID: "inv1", Tags: tags})
+	}))
+	defer server.Close()
+
+	client := NewClientWithOptions("test-api-key", server.URL, nil)
+	ctx := context.Background()
+
+	result, err := client.AddInvitationTags(ctx, "inv1", "spring")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if len(result.Tags) != 2 {
+		t.Errorf("Expected 2 tags, got %v", result.Tags)
+	}
+
+	result, err = client.RemoveInvitationTags(ctx, "inv1", "beta", "spring")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if len(result.Tags) != 0 {
+		t.Errorf("Expected no tags, got %v", result.Tags)
+	}
+}
+
+func TestTaggedWith(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if r.URL.Query().Get("tags") != "spring" {
+			t.Errorf("Expected tags filter 'spring', got '%s'", r.URL.Query().Get("tags"))
+		}
+		w.Write([]byte(`{"invitations": [{"id": "inv1", "tags": ["spring"]}]}`))
+	}))
+	defer server.Close()
+
+	client := NewClientWithOptions("test-api-key", server.URL, nil, WithCache(NewLRUCache(10, time.Minute)))
+
+	if _, err := client.GetInvitationsByGroup("workspace", "ws-123", TaggedWith("spring")); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	for i := 0; i < 2; i++ {
+		invitations, err := client.GetInvitationsByTarget("email", "test@example.com", TaggedWith("spring"))
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if len(invitations) != 1 {
+			t.Errorf("Expected 1 invitation, got %d", len(invitations))
+		}
+	}
+
+	if requests != 3 {
+		t.Errorf("Expected filtered lists to bypass the cache (3 requests), got %d", requests)
+	}
+}
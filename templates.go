@@ -0,0 +1,71 @@
+package vortex
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// EmailPreview is an invitation email rendered with sample data
+type EmailPreview struct {
+	Subject string `json:"subject"`
+	HTML    string `json:"html"`
+	Text    string `json:"text"`
+}
+
+// TemplateLocalization is a translated variant of an email template
+type TemplateLocalization struct {
+	Locale    string    `json:"locale"`
+	Subject   string    `json:"subject"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// PreviewInvitationEmail renders an invitation email template with sample
+// data, showing what invitees will receive without sending anything. Pass
+// Locale to preview one of the template's localizations.
+//
+// Example:
+//
+//	preview, err := client.PreviewInvitationEmail(ctx, templateID, map[string]interface{}{
+//	    "inviterName": "Ada",
+//	    "groupName":   "Engineering",
+//	}, vortex.Locale("de"))
+func (c *Client) PreviewInvitationEmail(ctx context.Context, templateID string, sampleData map[string]interface{}, opts ...RequestOption) (*EmailPreview, error) {
+	path := fmt.Sprintf("/api/v1/email-templates/%s/preview", templateID)
+
+	requestBody := map[string]interface{}{
+		"data": sampleData,
+	}
+
+	responseBody, err := c.apiRequestContext(ctx, "POST", path, requestBody, nil, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	var preview EmailPreview
+	if err := c.decode(responseBody, &preview); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	return &preview, nil
+}
+
+// ListTemplateLocalizations returns the languages an email template has been
+// translated into
+func (c *Client) ListTemplateLocalizations(ctx context.Context, templateID string, opts ...RequestOption) ([]TemplateLocalization, error) {
+	path := fmt.Sprintf("/api/v1/email-templates/%s/localizations", templateID)
+
+	responseBody, err := c.apiRequestContext(ctx, "GET", path, nil, nil, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	var response struct {
+		Localizations []TemplateLocalization `json:"localizations"`
+	}
+	if err := c.decode(responseBody, &response); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	return response.Localizations, nil
+}
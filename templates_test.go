@@ -0,0 +1,97 @@
+package vortex
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestPreviewInvitationEmail(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "POST" || r.URL.Path != "/api/v1/email-templates/tmpl-1/preview" {
+			t.Errorf("Expected POST /api/v1/email-templates/tmpl-1/preview, got %s %s", r.Method, r.URL.Path)
+		}
+
+		var req struct {
+			Data map[string]interface{} `json:"data"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Errorf("Failed to decode request body: %v", err)
+		}
+		if req.Data["inviterName"] != "Ada" {
+			t.Errorf("Expected inviterName to be 'Ada', got %v", req.Data["inviterName"])
+		}
+
+		w.Write([]byte(`{"subject": "Ada invited you", "html": "<p>Join Engineering</p>", "text": "Join Engineering"}`))
+	}))
+	defer server.Close()
+
+	client := NewClientWithOptions("test-api-key", server.URL, nil)
+
+	preview, err := client.PreviewInvitationEmail(context.Background(), "tmpl-1", map[string]interface{}{"inviterName": "Ada"})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if preview.Subject != "Ada invited you" {
+		t.Errorf("Expected subject 'Ada invited you', got %s", preview.Subject)
+	}
+	if preview.HTML != "<p>Join Engineering</p>" || preview.Text != "Join Engineering" {
+		t.Errorf("Expected rendered HTML and text, got %+v", preview)
+	}
+}
+
+func TestTemplateLocalizations(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v1/email-templates/tmpl-1/localizations":
+			w.Write([]byte(`{"localizations": [{"locale": "de", "subject": "Ada hat dich eingeladen", "updatedAt": "2026-01-01T00:00:00Z"}]}`))
+		case "/api/v1/email-templates/tmpl-1/preview":
+			if got := r.Header.Get("Accept-Language"); got != "de" {
+				t.Errorf("Expected Accept-Language de, got %s", got)
+			}
+			w.Write([]byte(`{"subject": "Ada hat dich eingeladen"}`))
+		}
+	}))
+	defer server.Close()
+
+	client := NewClientWithOptions("test-api-key", server.URL, nil, WithLocale("fr"))
+	ctx := context.Background()
+
+	localizations, err := client.ListTemplateLocalizations(ctx, "tmpl-1")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if len(localizations) != 1 || localizations[0].Locale != "de" {
+		t.Fatalf("Expected a de localization, got %+v", localizations)
+	}
+
+	preview, err := client.PreviewInvitationEmail(ctx, "tmpl-1", nil, Locale("de"))
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if preview.Subject != "Ada hat dich eingeladen" {
+		t.Errorf("Expected German subject, got %s", preview.Subject)
+	}
+}
+
+func TestWithLocale(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if got := r.Header.Get("Accept-Language"); got != "fr-CA" {
+			t.Errorf("Expected Accept-Language fr-CA, got %s", got)
+		}
+		w.Write([]byte(`{"id": "inv-123", "locale": "fr-CA"}`))
+	}))
+	defer server.Close()
+
+	client := NewClientWithOptions("test-api-key", server.URL, nil, WithLocale("fr-CA"))
+
+	invitation, err := client.GetInvitation("inv-123")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if invitation.Locale != "fr-CA" {
+		t.Errorf("Expected locale fr-CA, got %s", invitation.Locale)
+	}
+}
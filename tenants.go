@@ -0,0 +1,136 @@
+package vortex
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// ErrUnknownTenant is returned by MultiTenantClient for a tenant without an
+// API key
+var ErrUnknownTenant = errors.New("vortex: unknown tenant")
+
+// APIKeyFunc returns the Vortex API key of a tenant, e.g. from a database or
+// secret store
+type APIKeyFunc func(tenantID string) (string, error)
+
+// APIKeys looks tenants up in keys, failing with ErrUnknownTenant for tenants
+// not in it
+func APIKeys(keys map[string]string) APIKeyFunc {
+	return func(tenantID string) (string, error) {
+		key, ok := keys[tenantID]
+		if !ok {
+			return "", fmt.Errorf("%w %q", ErrUnknownTenant, tenantID)
+		}
+		return key, nil
+	}
+}
+
+// MultiTenantClient hands out a Client per tenant, for platforms managing
+// several Vortex projects
+//
+// Each tenant's client is created on first use with the shared options and
+// then reused, so its JWT signing key and rate limit state are kept per
+// tenant. A Cache given with WithCache is shared, with each tenant's keys
+// prefixed by its ID so no tenant reads another's entries. It is safe for
+// concurrent use.
+//
+// Example:
+//
+//	tenants := vortex.NewMultiTenantClient(vortex.APIKeys(map[string]string{
+//	    "acme":   os.Getenv("ACME_VORTEX_API_KEY"),
+//	    "globex": os.Getenv("GLOBEX_VORTEX_API_KEY"),
+//	}), vortex.WithRetry(3, time.Second))
+//
+//	client, err := tenants.Client(tenantID)
+//	if err != nil {
+//	    return err
+//	}
+//	jwt, err := client.GenerateJWT(user, nil)
+type MultiTenantClient struct {
+	keys APIKeyFunc
+	opts []ClientOption
+
+	mu      sync.Mutex
+	clients map[string]*Client
+}
+
+// NewMultiTenantClient creates a MultiTenantClient whose clients are created
+// with NewClient and opts
+func NewMultiTenantClient(keys APIKeyFunc, opts ...ClientOption) *MultiTenantClient {
+	return &MultiTenantClient{
+		keys:    keys,
+		opts:    opts,
+		clients: make(map[string]*Client),
+	}
+}
+
+// Client returns the tenant's client, creating it if needed
+func (m *MultiTenantClient) Client(tenantID string) (*Client, error) {
+	m.mu.Lock()
+	client, ok := m.clients[tenantID]
+	m.mu.Unlock()
+	if ok {
+		return client, nil
+	}
+
+	// Look the key up without the lock, since it may be slow
+	key, err := m.keys(tenantID)
+	if err != nil {
+		return nil, err
+	}
+	if key == "" {
+		return nil, fmt.Errorf("%w %q", ErrUnknownTenant, tenantID)
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if client, ok := m.clients[tenantID]; ok {
+		return client, nil
+	}
+	client = NewClient(key, m.opts...)
+	if client.cache != nil {
+		client.cache = tenantCache(client.cache, tenantID)
+	}
+	m.clients[tenantID] = client
+	return client, nil
+}
+
+// Forget drops the tenant's client, so the next call to Client looks its API
+// key up again, e.g. after the key was rotated
+func (m *MultiTenantClient) Forget(tenantID string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	delete(m.clients, tenantID)
+}
+
+// tenantCache prefixes the keys of cache with the tenant's ID
+func tenantCache(cache Cache, tenantID string) Cache {
+	prefixed := prefixedCache{cache: cache, prefix: "tenant:" + tenantID + ":"}
+	if stale, ok := cache.(StaleCache); ok {
+		return staleTenantCache{prefixed, stale}
+	}
+	return prefixed
+}
+
+type prefixedCache struct {
+	cache  Cache
+	prefix string
+}
+
+func (c prefixedCache) Get(key string) ([]byte, bool) { return c.cache.Get(c.prefix + key) }
+func (c prefixedCache) Set(key string, value []byte)  { c.cache.Set(c.prefix+key, value) }
+func (c prefixedCache) Delete(key string)             { c.cache.Delete(c.prefix + key) }
+
+// Clear clears every tenant's entries, since a Cache cannot clear by prefix
+func (c prefixedCache) Clear() { c.cache.Clear() }
+
+type staleTenantCache struct {
+	prefixedCache
+	stale StaleCache
+}
+
+func (c staleTenantCache) GetStale(key string) ([]byte, time.Time, bool) {
+	return c.stale.GetStale(c.prefix + key)
+}
@@ -0,0 +1,101 @@
+package vortex
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestAPIKeyOption(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if got := r.Header.Get("x-api-key"); got != "tenant-key" {
+			t.Errorf("Expected tenant-key, got %s", got)
+		}
+		w.Write([]byte(`{"id": "inv-1"}`))
+	}))
+	defer server.Close()
+
+	client := NewClientWithOptions("test-api-key", server.URL, nil)
+
+	if _, err := client.GetInvitationContext(context.Background(), "inv-1", APIKey("tenant-key")); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+}
+
+func TestMultiTenantClient(t *testing.T) {
+	var mu sync.Mutex
+	lookups := make(map[string]int)
+	keys := APIKeys(map[string]string{
+		"acme":   "VRTX.EjRWeBI0EjQSNBI0VniQEg.acme-key",
+		"globex": "VRTX.EjRWeBI0EjQSNBI0VniQEg.globex-key",
+	})
+	tenants := NewMultiTenantClient(func(tenantID string) (string, error) {
+		mu.Lock()
+		lookups[tenantID]++
+		mu.Unlock()
+		return keys(tenantID)
+	}, WithUserAgent("platform/1.0"))
+
+	acme, err := tenants.Client("acme")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if again, _ := tenants.Client("acme"); again != acme {
+		t.Error("Expected the tenant's client to be reused")
+	}
+	globex, _ := tenants.Client("globex")
+	if globex == acme || globex.apiKey != "VRTX.EjRWeBI0EjQSNBI0VniQEg.globex-key" {
+		t.Error("Expected a separate client with the tenant's key")
+	}
+	if acme.userAgent != "platform/1.0" {
+		t.Errorf("Expected the shared options to apply, got %q", acme.userAgent)
+	}
+
+	// Tokens are signed with the tenant's key
+	jwt, _ := acme.GenerateJWT(&User{ID: "user-1"}, nil)
+	if _, err := globex.VerifyJWT(jwt); err == nil {
+		t.Error("Expected another tenant's key to reject the token")
+	}
+
+	tenants.Forget("acme")
+	tenants.Client("acme")
+	if lookups["acme"] != 2 {
+		t.Errorf("Expected the key to be looked up again after Forget, got %d lookups", lookups["acme"])
+	}
+
+	if _, err := tenants.Client("initech"); !errors.Is(err, ErrUnknownTenant) {
+		t.Errorf("Expected ErrUnknownTenant, got %v", err)
+	}
+}
+
+func TestMultiTenantClient_SharedCache(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// Each tenant's project has its own invitation with the same ID
+		w.Write([]byte(`{"id": "inv-1", "widgetConfigurationId": "` + r.Header.Get("x-api-key") + `"}`))
+	}))
+	defer server.Close()
+
+	cache := NewLRUCache(100, time.Minute)
+	tenants := NewMultiTenantClient(APIKeys(map[string]string{
+		"acme":   "acme-key",
+		"globex": "globex-key",
+	}), WithBaseURL(server.URL), WithCache(cache))
+
+	for _, tenantID := range []string{"acme", "globex", "acme"} {
+		client, err := tenants.Client(tenantID)
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		invitation, err := client.GetInvitation("inv-1")
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if invitation.WidgetConfigurationID != tenantID+"-key" {
+			t.Errorf("Expected %s's invitation, got %s's", tenantID, invitation.WidgetConfigurationID)
+		}
+	}
+}
@@ -0,0 +1,86 @@
+package vortex
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// ThrottleSettings caps how many invitations can be sent; zero means no cap
+type ThrottleSettings struct {
+	ProjectPerHour int `json:"projectPerHour,omitempty"`
+	ProjectPerDay  int `json:"projectPerDay,omitempty"`
+	InviterPerHour int `json:"inviterPerHour,omitempty"`
+	InviterPerDay  int `json:"inviterPerDay,omitempty"`
+}
+
+// ThrottleState is the current send count against each throttle
+type ThrottleState struct {
+	Settings        ThrottleSettings `json:"settings"`
+	ProjectSentHour int              `json:"projectSentHour"`
+	ProjectSentDay  int              `json:"projectSentDay"`
+	InviterSentHour int              `json:"inviterSentHour"`
+	InviterSentDay  int              `json:"inviterSentDay"`
+	// Throttled is true when any cap has been reached
+	Throttled bool `json:"throttled"`
+	// ResetAt is when the earliest reached cap resets; nil when not
+	// throttled
+	ResetAt *time.Time `json:"resetAt,omitempty"`
+}
+
+// GetThrottleSettings retrieves the project's send throttles
+func (c *Client) GetThrottleSettings(ctx context.Context, opts ...RequestOption) (*ThrottleSettings, error) {
+	responseBody, err := c.apiRequestContext(ctx, "GET", "/api/v1/settings/throttle", nil, nil, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	var settings ThrottleSettings
+	if err := c.decode(responseBody, &settings); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	return &settings, nil
+}
+
+// UpdateThrottleSettings replaces the project's send throttles
+//
+// Example:
+//
+//	settings, err := client.UpdateThrottleSettings(ctx, vortex.ThrottleSettings{
+//	    ProjectPerDay: 5000,
+//	    InviterPerDay: 50,
+//	})
+func (c *Client) UpdateThrottleSettings(ctx context.Context, settings ThrottleSettings, opts ...RequestOption) (*ThrottleSettings, error) {
+	responseBody, err := c.apiRequestContext(ctx, "PUT", "/api/v1/settings/throttle", settings, nil, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	var updated ThrottleSettings
+	if err := c.decode(responseBody, &updated); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	return &updated, nil
+}
+
+// GetThrottleState retrieves the current send counts for the project and for
+// one inviter, e.g. to show "you've hit today's limit"
+func (c *Client) GetThrottleState(ctx context.Context, inviterID string, opts ...RequestOption) (*ThrottleState, error) {
+	queryParams := map[string]string{
+		"inviterId": inviterID,
+	}
+
+	responseBody, err := c.apiRequestContext(ctx, "GET", "/api/v1/throttle", nil, queryParams, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	var state ThrottleState
+	if err := c.decode(responseBody, &state); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	return &state, nil
+}
@@ -0,0 +1,67 @@
+package vortex
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestThrottleSettings(t *testing.T) {
+	var stored ThrottleSettings
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v1/settings/throttle" {
+			t.Errorf("Expected path /api/v1/settings/throttle, got %s", r.URL.Path)
+		}
+		if r.Method == "PUT" {
+			stored = ThrottleSettings{}
+			if err := json.NewDecoder(r.Body).Decode(&stored); err != nil {
+				t.Errorf("Failed to decode request body: %v", err)
+			}
+		}
+		json.NewEncoder(w).Encode(stored)
+	}))
+	defer server.Close()
+
+	client := NewClientWithOptions("test-api-key", server.URL, nil)
+	ctx := context.Background()
+
+	if _, err := client.UpdateThrottleSettings(ctx, ThrottleSettings{ProjectPerDay: 5000, InviterPerDay: 50}); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	settings, err := client.GetThrottleSettings(ctx)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if settings.ProjectPerDay != 5000 || settings.InviterPerDay != 50 || settings.ProjectPerHour != 0 {
+		t.Errorf("Unexpected settings %+v", settings)
+	}
+}
+
+func TestGetThrottleState(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v1/throttle" || r.URL.Query().Get("inviterId") != "user-1" {
+			t.Errorf("Expected /api/v1/throttle?inviterId=user-1, got %s", r.URL.String())
+		}
+		w.Write([]byte(`{
+			"settings": {"inviterPerDay": 50},
+			"inviterSentDay": 50,
+			"throttled": true,
+			"resetAt": "2024-01-02T00:00:00Z"
+		}`))
+	}))
+	defer server.Close()
+
+	client := NewClientWithOptions("test-api-key", server.URL, nil)
+
+	state, err := client.GetThrottleState(context.Background(), "user-1")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if !state.Throttled || state.InviterSentDay != state.Settings.InviterPerDay {
+		t.Errorf("Expected the inviter to be throttled, got %+v", state)
+	}
+}
@@ -0,0 +1,115 @@
+package vortex
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"strings"
+	"time"
+)
+
+// TimestampLayout is the layout the API used for timestamp fields when the
+// SDK exposed them as strings, e.g. "2025-01-27T12:00:00.000Z"
+const TimestampLayout = "2006-01-02T15:04:05.000Z07:00"
+
+// FormatTimestamp formats t the way the old string timestamp fields held it,
+// for code that still compares or stores those strings; it returns "" for nil
+// or the zero time
+//
+// Example:
+//
+//	// Before: modified := *invitation.ModifiedAt
+//	modified := vortex.FormatTimestamp(invitation.ModifiedAt)
+func FormatTimestamp(t *time.Time) string {
+	if t == nil || t.IsZero() {
+		return ""
+	}
+	return t.UTC().Format(TimestampLayout)
+}
+
+var timeType = reflect.TypeOf(time.Time{})
+
+// unmarshal decodes data into v, retrying with normalized timestamps if the
+// first attempt fails so that "" and unix seconds, which older API versions
+// sent for some timestamp fields, still decode
+func unmarshal(data []byte, v interface{}) error {
+	err := json.Unmarshal(data, v)
+	if err == nil {
+		return nil
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	var raw interface{}
+	if decoder.Decode(&raw) != nil {
+		return err
+	}
+	if !normalizeTimestamps(reflect.TypeOf(v), raw) {
+		return err
+	}
+	normalized, marshalErr := json.Marshal(raw)
+	if marshalErr != nil || json.Unmarshal(normalized, v) != nil {
+		return err
+	}
+	return nil
+}
+
+// normalizeTimestamps rewrites the time.Time fields of raw, which was decoded
+// from JSON for a value of type t, and reports whether it changed any
+func normalizeTimestamps(t reflect.Type, raw interface{}) bool {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	changed := false
+	switch t.Kind() {
+	case reflect.Struct:
+		object, ok := raw.(map[string]interface{})
+		if !ok {
+			return false
+		}
+		fields := jsonFields(t)
+		for key, value := range object {
+			field, ok := fields[strings.ToLower(key)]
+			if !ok {
+				continue
+			}
+			for field.Kind() == reflect.Ptr {
+				field = field.Elem()
+			}
+			if field != timeType {
+				changed = normalizeTimestamps(field, value) || changed
+				continue
+			}
+			switch value := value.(type) {
+			case string:
+				if value == "" {
+					object[key] = nil
+					changed = true
+				}
+			case json.Number:
+				if seconds, err := value.Int64(); err == nil {
+					object[key] = time.Unix(seconds, 0).UTC().Format(time.RFC3339)
+					changed = true
+				}
+			}
+		}
+	case reflect.Slice, reflect.Array:
+		items, ok := raw.([]interface{})
+		if !ok {
+			return false
+		}
+		for _, item := range items {
+			changed = normalizeTimestamps(t.Elem(), item) || changed
+		}
+	case reflect.Map:
+		object, ok := raw.(map[string]interface{})
+		if !ok {
+			return false
+		}
+		for _, value := range object {
+			changed = normalizeTimestamps(t.Elem(), value) || changed
+		}
+	}
+	return changed
+}
@@ -0,0 +1,107 @@
+package vortex
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestTimestampDecoding(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{
+			"id": "inv-1",
+			"createdAt": "2025-01-27T12:00:00.000Z",
+			"modifiedAt": null,
+			"expires": "2025-02-03T12:00:00Z",
+			"accepts": [{"id": "acc-1", "acceptedAt": "2025-01-28T09:30:00.000Z"}]
+		}`))
+	}))
+	defer server.Close()
+
+	client := NewClientWithOptions("test-api-key", server.URL, nil)
+
+	invitation, err := client.GetInvitation("inv-1")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if want := time.Date(2025, 1, 27, 12, 0, 0, 0, time.UTC); !invitation.CreatedAt.Equal(want) {
+		t.Errorf("Expected createdAt %v, got %v", want, invitation.CreatedAt)
+	}
+	if invitation.ModifiedAt != nil {
+		t.Errorf("Expected nil modifiedAt, got %v", invitation.ModifiedAt)
+	}
+	if invitation.Expires == nil || invitation.Expires.Day() != 3 {
+		t.Errorf("Expected expires on Feb 3, got %v", invitation.Expires)
+	}
+	if invitation.Accepts[0].AcceptedAt.Hour() != 9 {
+		t.Errorf("Expected acceptedAt at 09:30, got %v", invitation.Accepts[0].AcceptedAt)
+	}
+}
+
+func TestTimestampDecoding_Legacy(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{
+			"invitations": [{
+				"id": "inv-1",
+				"createdAt": 1737979200,
+				"modifiedAt": "",
+				"groups": [{"id": "g-1", "createdAt": ""}]
+			}]
+		}`))
+	}))
+	defer server.Close()
+
+	client := NewClientWithOptions("test-api-key", server.URL, nil, WithStrictDecoding())
+
+	invitations, err := client.GetInvitationsByTarget("email", "user@example.com")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if want := time.Date(2025, 1, 27, 12, 0, 0, 0, time.UTC); !invitations[0].CreatedAt.Equal(want) {
+		t.Errorf("Expected createdAt %v, got %v", want, invitations[0].CreatedAt)
+	}
+	if invitations[0].ModifiedAt != nil {
+		t.Errorf("Expected empty modifiedAt to decode as nil, got %v", invitations[0].ModifiedAt)
+	}
+	if !invitations[0].Groups[0].CreatedAt.IsZero() {
+		t.Errorf("Expected empty group createdAt to decode as zero, got %v", invitations[0].Groups[0].CreatedAt)
+	}
+}
+
+func TestTimestampDecoding_Invalid(t *testing.T) {
+	var invitation InvitationResult
+	if err := unmarshal([]byte(`{"createdAt": "yesterday"}`), &invitation); err == nil {
+		t.Error("Expected error for invalid createdAt")
+	}
+}
+
+func TestTimestampEncoding(t *testing.T) {
+	data, err := json.Marshal(CreateInvitationRequest{
+		Expires: ExpiryTime(time.Date(2026, 12, 1, 12, 0, 0, 0, time.FixedZone("EST", -5*3600))),
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	var body map[string]interface{}
+	json.Unmarshal(data, &body)
+	if body["expires"] != "2026-12-01T17:00:00Z" {
+		t.Errorf("Expected expires 2026-12-01T17:00:00Z, got %v", body["expires"])
+	}
+}
+
+func TestFormatTimestamp(t *testing.T) {
+	created := time.Date(2025, 1, 27, 13, 0, 0, 0, time.FixedZone("CET", 3600))
+
+	if got := FormatTimestamp(&created); got != "2025-01-27T12:00:00.000Z" {
+		t.Errorf("Expected 2025-01-27T12:00:00.000Z, got %s", got)
+	}
+	if got := FormatTimestamp(nil); got != "" {
+		t.Errorf("Expected empty string for nil, got %s", got)
+	}
+	if got := FormatTimestamp(&time.Time{}); got != "" {
+		t.Errorf("Expected empty string for zero time, got %s", got)
+	}
+}
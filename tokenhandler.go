@@ -0,0 +1,138 @@
+package vortex
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// UserFromRequestFunc returns the signed-in user making r, e.g. from the
+// application's session; an error or nil user rejects the request
+type UserFromRequestFunc func(r *http.Request) (*User, error)
+
+// TokenHandlerOption customizes TokenHandler
+type TokenHandlerOption func(*tokenHandler)
+
+// AllowOrigins lets pages on the given origins, such as
+// "https://app.example.com", fetch tokens with credentials. Without it only
+// same-origin requests are served; requests from other origins get a 403.
+//
+// "*" allows any origin but without credentials, so browsers send no cookies
+// and userFromRequest must authenticate the request some other way, e.g. by
+// its Authorization header.
+func AllowOrigins(origins ...string) TokenHandlerOption {
+	return func(h *tokenHandler) {
+		h.origins = append(h.origins, origins...)
+	}
+}
+
+// TokenJWTOptions sets the lifetime of the issued tokens
+func TokenJWTOptions(opts JWTOptions) TokenHandlerOption {
+	return func(h *tokenHandler) {
+		h.jwt = opts
+	}
+}
+
+// TokenHandler returns an http.Handler that issues a JWT for the signed-in
+// user, for the Vortex widget to fetch
+//
+// GET and POST requests get {"token": "..."} with caching disabled, or a 401
+// when userFromRequest rejects them. CORS preflight requests from allowed
+// origins are answered directly.
+//
+// Example:
+//
+//	http.Handle("/vortex/token", vortex.TokenHandler(client, func(r *http.Request) (*vortex.User, error) {
+//	    session, err := sessions.Get(r)
+//	    if err != nil {
+//	        return nil, err
+//	    }
+//	    return &vortex.User{ID: session.UserID, Email: session.Email}, nil
+//	}, vortex.AllowOrigins("https://app.example.com")))
+func TokenHandler(client *Client, userFromRequest UserFromRequestFunc, opts ...TokenHandlerOption) http.Handler {
+	h := &tokenHandler{client: client, userFromRequest: userFromRequest}
+	for _, opt := range opts {
+		opt(h)
+	}
+	return h
+}
+
+type tokenHandler struct {
+	client          *Client
+	userFromRequest UserFromRequestFunc
+	origins         []string
+	jwt             JWTOptions
+}
+
+func (h *tokenHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	header := w.Header()
+	header.Add("Vary", "Origin")
+	if origin := r.Header.Get("Origin"); origin != "" && !sameOrigin(r, origin) {
+		switch {
+		case h.listed(origin):
+			header.Set("Access-Control-Allow-Origin", origin)
+			header.Set("Access-Control-Allow-Credentials", "true")
+		case h.listed("*"):
+			// A wildcard never allows credentials, or any site could fetch
+			// a token with the user's cookies
+			header.Set("Access-Control-Allow-Origin", "*")
+		default:
+			writeTokenResponse(w, http.StatusForbidden, map[string]string{"error": "origin not allowed"})
+			return
+		}
+	}
+
+	switch r.Method {
+	case http.MethodOptions:
+		header.Set("Access-Control-Allow-Methods", "GET, POST, OPTIONS")
+		header.Set("Access-Control-Allow-Headers", "Authorization, Content-Type")
+		header.Set("Access-Control-Max-Age", "600")
+		w.WriteHeader(http.StatusNoContent)
+		return
+	case http.MethodGet, http.MethodPost:
+	default:
+		header.Set("Allow", "GET, POST, OPTIONS")
+		writeTokenResponse(w, http.StatusMethodNotAllowed, map[string]string{"error": "method not allowed"})
+		return
+	}
+
+	user, err := h.userFromRequest(r)
+	if err != nil || user == nil {
+		writeTokenResponse(w, http.StatusUnauthorized, map[string]string{"error": "unauthorized"})
+		return
+	}
+
+	token, err := h.client.GenerateJWTWithOptions(user, nil, h.jwt)
+	if err != nil {
+		writeTokenResponse(w, http.StatusInternalServerError, map[string]string{"error": "failed to generate token"})
+		return
+	}
+	writeTokenResponse(w, http.StatusOK, map[string]string{"token": token})
+}
+
+// listed reports whether origin was given to AllowOrigins
+func (h *tokenHandler) listed(origin string) bool {
+	for _, allowed := range h.origins {
+		if strings.EqualFold(allowed, origin) {
+			return true
+		}
+	}
+	return false
+}
+
+// sameOrigin reports whether origin is the host r was sent to
+func sameOrigin(r *http.Request, origin string) bool {
+	u, err := url.Parse(origin)
+	return err == nil && strings.EqualFold(u.Host, r.Host)
+}
+
+// writeTokenResponse writes body as uncacheable JSON, since it may hold a token
+func writeTokenResponse(w http.ResponseWriter, status int, body map[string]string) {
+	header := w.Header()
+	header.Set("Content-Type", "application/json")
+	header.Set("Cache-Control", "no-store")
+	header.Set("Pragma", "no-cache")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(body)
+}
@@ -0,0 +1,133 @@
+package vortex
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func testUserFromRequest(r *http.Request) (*User, error) {
+	if r.Header.Get("Cookie") != "session=ok" {
+		return nil, errors.New("not signed in")
+	}
+	return &User{ID: "user-123", Email: "test@example.com"}, nil
+}
+
+func TestTokenHandler(t *testing.T) {
+	client := NewClient("VRTX.EjRWeBI0EjQSNBI0VniQEg.test-key")
+	handler := TokenHandler(client, testUserFromRequest, AllowOrigins("https://app.example.com"))
+
+	req := httptest.NewRequest("GET", "/vortex/token", nil)
+	req.Header.Set("Cookie", "session=ok")
+	req.Header.Set("Origin", "https://app.example.com")
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("Expected status 200, got %d", rec.Code)
+	}
+	if got := rec.Header().Get("Cache-Control"); got != "no-store" {
+		t.Errorf("Expected Cache-Control no-store, got %s", got)
+	}
+	if got := rec.Header().Get("Access-Control-Allow-Origin"); got != "https://app.example.com" {
+		t.Errorf("Expected the origin to be allowed, got %q", got)
+	}
+
+	var body struct {
+		Token string `json:"token"`
+	}
+	if err := json.Unmarshal(rec.Body.Bytes(), &body); err != nil {
+		t.Fatalf("Expected JSON, got %s", rec.Body.String())
+	}
+	claims, err := client.VerifyJWT(body.Token)
+	if err != nil {
+		t.Fatalf("Expected a valid token, got %v", err)
+	}
+	if claims.UserID != "user-123" {
+		t.Errorf("Expected user-123, got %s", claims.UserID)
+	}
+}
+
+func TestTokenHandler_Unauthorized(t *testing.T) {
+	client := NewClient("VRTX.EjRWeBI0EjQSNBI0VniQEg.test-key")
+	handler := TokenHandler(client, testUserFromRequest)
+
+	req := httptest.NewRequest("POST", "/vortex/token", nil)
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusUnauthorized {
+		t.Errorf("Expected status 401, got %d", rec.Code)
+	}
+}
+
+func TestTokenHandler_ForeignOrigin(t *testing.T) {
+	client := NewClient("VRTX.EjRWeBI0EjQSNBI0VniQEg.test-key")
+
+	tests := []struct {
+		name    string
+		origins []string
+		status  int
+		allow   string
+	}{
+		{"unlisted", []string{"https://app.example.com"}, http.StatusForbidden, ""},
+		{"none allowed", nil, http.StatusForbidden, ""},
+		{"wildcard", []string{"*"}, http.StatusOK, "*"},
+	}
+	for _, tt := range tests {
+		handler := TokenHandler(client, testUserFromRequest, AllowOrigins(tt.origins...))
+
+		req := httptest.NewRequest("GET", "https://app.example.com/vortex/token", nil)
+		req.Header.Set("Cookie", "session=ok")
+		req.Header.Set("Origin", "https://evil.example.com")
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+
+		if rec.Code != tt.status {
+			t.Errorf("%s: Expected status %d, got %d", tt.name, tt.status, rec.Code)
+		}
+		if got := rec.Header().Get("Access-Control-Allow-Origin"); got != tt.allow {
+			t.Errorf("%s: Expected Access-Control-Allow-Origin %q, got %q", tt.name, tt.allow, got)
+		}
+		if got := rec.Header().Get("Access-Control-Allow-Credentials"); got != "" {
+			t.Errorf("%s: Expected no credentials for a foreign origin, got %q", tt.name, got)
+		}
+	}
+
+	// Same-origin requests need no AllowOrigins
+	handler := TokenHandler(client, testUserFromRequest)
+	req := httptest.NewRequest("POST", "https://app.example.com/vortex/token", nil)
+	req.Header.Set("Cookie", "session=ok")
+	req.Header.Set("Origin", "https://app.example.com")
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	if rec.Code != http.StatusOK {
+		t.Errorf("Expected same-origin requests to be served, got %d", rec.Code)
+	}
+}
+
+func TestTokenHandler_Preflight(t *testing.T) {
+	client := NewClient("VRTX.EjRWeBI0EjQSNBI0VniQEg.test-key")
+	handler := TokenHandler(client, testUserFromRequest, AllowOrigins("*"))
+
+	req := httptest.NewRequest("OPTIONS", "/vortex/token", nil)
+	req.Header.Set("Origin", "https://app.example.com")
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusNoContent {
+		t.Errorf("Expected status 204, got %d", rec.Code)
+	}
+	if got := rec.Header().Get("Access-Control-Allow-Methods"); got != "GET, POST, OPTIONS" {
+		t.Errorf("Expected allowed methods, got %q", got)
+	}
+
+	req = httptest.NewRequest("DELETE", "/vortex/token", nil)
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	if rec.Code != http.StatusMethodNotAllowed {
+		t.Errorf("Expected status 405, got %d", rec.Code)
+	}
+}
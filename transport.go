@@ -0,0 +1,182 @@
+package vortex
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// tokenRefreshMargin is how long before expiry a cached JWT is replaced
+const tokenRefreshMargin = 5 * time.Minute
+
+type userContextKey struct{}
+
+// ContextWithUser returns a copy of ctx carrying the user on whose behalf
+// outbound requests are made
+func ContextWithUser(ctx context.Context, user *User) context.Context {
+	return context.WithValue(ctx, userContextKey{}, user)
+}
+
+// UserFromContext returns the user stored in ctx by ContextWithUser
+func UserFromContext(ctx context.Context) (*User, bool) {
+	user, ok := ctx.Value(userContextKey{}).(*User)
+	return user, ok && user != nil
+}
+
+// AsUser returns a client whose requests are made on behalf of user
+//
+// Each request carries a JWT for the user as a bearer token alongside the API
+// key, so the Vortex API attributes the action to that user. Tokens are
+// cached and shared with the parent client. The returned client does not read
+// the response cache, since responses may differ per user, but its changes
+// still invalidate the parent's cached entries.
+//
+// Example:
+//
+//	_, err := client.AsUser(user).Reinvite(invitationID)
+func (c *Client) AsUser(user *User) *Client {
+	userClient := *c
+	if userClient.tokens == nil {
+		userClient.tokens = NewTokenTransport(c, nil)
+	}
+	userClient.asUser = user
+	return &userClient
+}
+
+// TokenCache stores minted JWTs until shortly before they expire
+type TokenCache interface {
+	Get(key string) (string, bool)
+	Set(key, token string, ttl time.Duration)
+}
+
+// TokenTransport is an http.RoundTripper that attaches a Vortex JWT for the
+// user in the request context as a bearer token
+//
+// Requests whose context carries no user are passed through unchanged.
+//
+// Example:
+//
+//	httpClient := &http.Client{Transport: vortex.NewTokenTransport(client, nil)}
+//	req, _ := http.NewRequestWithContext(vortex.ContextWithUser(ctx, user), "GET", url, nil)
+//	resp, err := httpClient.Do(req)
+type TokenTransport struct {
+	// Client mints the JWTs
+	Client *Client
+	// Base is the underlying transport; http.DefaultTransport is used when nil
+	Base http.RoundTripper
+	// Cache holds minted tokens; an in-memory cache is used when nil
+	Cache TokenCache
+
+	once sync.Once
+}
+
+// NewTokenTransport creates a transport that mints JWTs with the given client
+func NewTokenTransport(client *Client, base http.RoundTripper) *TokenTransport {
+	return &TokenTransport{Client: client, Base: base}
+}
+
+// RoundTrip implements http.RoundTripper
+func (t *TokenTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	base := t.Base
+	if base == nil {
+		base = http.DefaultTransport
+	}
+
+	user, ok := UserFromContext(req.Context())
+	if !ok {
+		return base.RoundTrip(req)
+	}
+
+	token, err := t.Token(user)
+	if err != nil {
+		if req.Body != nil {
+			req.Body.Close()
+		}
+		return nil, err
+	}
+
+	// RoundTrippers must not modify the caller's request
+	req = req.Clone(req.Context())
+	req.Header.Set("Authorization", "Bearer "+token)
+	return base.RoundTrip(req)
+}
+
+// Token returns a cached JWT for the user, minting a new one when needed
+func (t *TokenTransport) Token(user *User) (string, error) {
+	t.once.Do(func() {
+		if t.Cache == nil {
+			t.Cache = newMemoryTokenCache()
+		}
+	})
+
+	key := t.Client.tokenCacheKey(user)
+	if token, ok := t.Cache.Get(key); ok {
+		return token, nil
+	}
+
+	token, err := t.Client.GenerateJWT(user, nil)
+	if err != nil {
+		return "", err
+	}
+
+	t.Cache.Set(key, token, jwtTTL-tokenRefreshMargin)
+	return token, nil
+}
+
+// tokenCacheKey identifies the JWT minted for user by this client's API key
+//
+// The fields are JSON encoded so that no two users share a key, and hashed so
+// that user details are not stored in shared caches.
+func (c *Client) tokenCacheKey(user *User) string {
+	var keyID string
+	if key, err := c.signingKey(); err == nil {
+		keyID = key.ID
+	}
+
+	fields, _ := json.Marshal([]interface{}{keyID, user.ID, user.Email, user.AdminScopes})
+	sum := sha256.Sum256(fields)
+	return hex.EncodeToString(sum[:])
+}
+
+// memoryTokenCache is the default in-process TokenCache
+type memoryTokenCache struct {
+	mu      sync.Mutex
+	entries map[string]memoryToken
+}
+
+type memoryToken struct {
+	token   string
+	expires time.Time
+}
+
+func newMemoryTokenCache() *memoryTokenCache {
+	return &memoryTokenCache{entries: make(map[string]memoryToken)}
+}
+
+func (c *memoryTokenCache) Get(key string) (string, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, ok := c.entries[key]
+	if !ok || time.Now().After(entry.expires) {
+		return "", false
+	}
+	return entry.token, true
+}
+
+func (c *memoryTokenCache) Set(key, token string, ttl time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := time.Now()
+	for k, entry := range c.entries {
+		if now.After(entry.expires) {
+			delete(c.entries, k)
+		}
+	}
+	c.entries[key] = memoryToken{token: token, expires: now.Add(ttl)}
+}
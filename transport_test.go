@@ -0,0 +1,173 @@
+package vortex
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestTokenTransport(t *testing.T) {
+	client := NewClient("VRTX.EjRWeBI0EjQSNBI0VniQEg.test-key")
+
+	var tokens []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		tokens = append(tokens, r.Header.Get("Authorization"))
+	}))
+	defer server.Close()
+
+	httpClient := &http.Client{Transport: NewTokenTransport(client, nil)}
+	user := &User{ID: "user-123", Email: "test@example.com"}
+	ctx := ContextWithUser(context.Background(), user)
+
+	for i := 0; i < 2; i++ {
+		req, _ := http.NewRequestWithContext(ctx, "GET", server.URL, nil)
+		resp, err := httpClient.Do(req)
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		resp.Body.Close()
+
+		if req.Header.Get("Authorization") != "" {
+			t.Error("Expected caller's request to be left unmodified")
+		}
+	}
+
+	if !strings.HasPrefix(tokens[0], "Bearer ") {
+		t.Fatalf("Expected bearer token, got %q", tokens[0])
+	}
+	if tokens[0] != tokens[1] {
+		t.Error("Expected cached token to be reused")
+	}
+
+	claims, err := client.VerifyJWT(strings.TrimPrefix(tokens[0], "Bearer "))
+	if err != nil {
+		t.Fatalf("Expected valid JWT, got %v", err)
+	}
+	if claims.UserID != "user-123" {
+		t.Errorf("Expected userId to be 'user-123', got %s", claims.UserID)
+	}
+}
+
+func TestTokenTransport_NoUser(t *testing.T) {
+	client := NewClient("VRTX.EjRWeBI0EjQSNBI0VniQEg.test-key")
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "" {
+			t.Errorf("Expected no Authorization header, got %q", r.Header.Get("Authorization"))
+		}
+	}))
+	defer server.Close()
+
+	httpClient := &http.Client{Transport: NewTokenTransport(client, nil)}
+	resp, err := httpClient.Get(server.URL)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	resp.Body.Close()
+}
+
+func TestTokenTransport_CacheKeyPerUser(t *testing.T) {
+	transport := NewTokenTransport(NewClient("VRTX.EjRWeBI0EjQSNBI0VniQEg.test-key"), nil)
+
+	first, err := transport.Token(&User{ID: "a|b", Email: "c"})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	second, err := transport.Token(&User{ID: "a", Email: "b|c"})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	claims, err := transport.Client.VerifyJWT(second)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if first == second || claims.UserID != "a" {
+		t.Errorf("Expected a separate token for each user, got userId %s", claims.UserID)
+	}
+}
+
+func TestAsUser(t *testing.T) {
+	var authorization []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		authorization = append(authorization, r.Header.Get("Authorization"))
+		if r.Header.Get("x-api-key") == "" {
+			t.Error("Expected x-api-key header alongside the user token")
+		}
+		w.Write([]byte(`{"id":"inv-1"}`))
+	}))
+	defer server.Close()
+
+	client := NewClientWithOptions("VRTX.EjRWeBI0EjQSNBI0VniQEg.test-key", server.URL, nil)
+	userClient := client.AsUser(&User{ID: "user-123", Email: "test@example.com"})
+
+	userClient.Reinvite("inv-1")
+	userClient.Reinvite("inv-1")
+	client.Reinvite("inv-1")
+
+	if len(authorization) != 3 {
+		t.Fatalf("Expected 3 requests, got %d", len(authorization))
+	}
+	if !strings.HasPrefix(authorization[0], "Bearer ") {
+		t.Fatalf("Expected bearer token, got %q", authorization[0])
+	}
+	if authorization[1] != authorization[0] {
+		t.Error("Expected the cached token to be reused")
+	}
+	if authorization[2] != "" {
+		t.Errorf("Expected no user token on the parent client, got %q", authorization[2])
+	}
+
+	claims, err := client.VerifyJWT(strings.TrimPrefix(authorization[0], "Bearer "))
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if claims.UserID != "user-123" {
+		t.Errorf("Expected userId 'user-123', got %s", claims.UserID)
+	}
+}
+
+func TestAsUser_InvalidatesCache(t *testing.T) {
+	status := "pending"
+	gets := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case "DELETE":
+			status = "revoked"
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			gets++
+			w.Write([]byte(`{"id": "inv-1", "status": "` + status + `"}`))
+		}
+	}))
+	defer server.Close()
+
+	client := NewClientWithOptions("VRTX.EjRWeBI0EjQSNBI0VniQEg.test-key", server.URL, nil,
+		WithCache(NewLRUCache(100, time.Minute)))
+	userClient := client.AsUser(&User{ID: "user-123"})
+
+	if _, err := client.GetInvitation("inv-1"); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	// The user's read bypasses the cache without filling it
+	if _, err := userClient.GetInvitation("inv-1"); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if gets != 2 {
+		t.Errorf("Expected the user's read to skip the cache, got %d requests", gets)
+	}
+
+	if err := userClient.RevokeInvitation("inv-1"); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	invitation, err := client.GetInvitation("inv-1")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if invitation.Status != "revoked" {
+		t.Errorf("Expected the user's revoke to invalidate the parent's cache, got %s", invitation.Status)
+	}
+}
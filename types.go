@@ -1,5 +1,11 @@
 package vortex
 
+import (
+	"errors"
+	"net/http"
+	"time"
+)
+
 // User represents user data for JWT generation
 type User struct {
 	ID          string   `json:"id"`
@@ -9,78 +15,149 @@ type User struct {
 
 // InvitationTarget represents the target of an invitation
 type InvitationTarget struct {
-	Type  string `json:"type"`
-	Value string `json:"value"`
+	Type  TargetType `json:"type"`
+	Value string     `json:"value"`
 }
 
 // InvitationGroup represents a group associated with an invitation
 // This matches the MemberGroups table structure from the API response
 type InvitationGroup struct {
-	ID        string `json:"id"`        // Vortex internal UUID
-	AccountID string `json:"accountId"` // Vortex account ID
-	GroupID   string `json:"groupId"`   // Customer's group ID (the ID they provided)
-	Type      string `json:"type"`      // Group type (e.g., "workspace", "team")
-	Name      string `json:"name"`      // Group name
-	CreatedAt string `json:"createdAt"` // Timestamp when the group was created
+	ID        string    `json:"id"`                  // Vortex internal UUID
+	AccountID string    `json:"accountId"`           // Vortex account ID
+	GroupID   string    `json:"groupId"`             // Customer's group ID (the ID they provided)
+	Type      string    `json:"type"`                // Group type (e.g., "workspace", "team")
+	Name      string    `json:"name"`                // Group name
+	CreatedAt time.Time `json:"createdAt"`           // Timestamp when the group was created
+	SeatLimit *int      `json:"seatLimit,omitempty"` // Maximum members, nil when unlimited
 }
 
 // InvitationAcceptance represents an accepted invitation
 type InvitationAcceptance struct {
-	ID         string            `json:"id"`
-	AccountID  string            `json:"accountId"`
-	ProjectID  string            `json:"projectId"`
-	AcceptedAt string            `json:"acceptedAt"`
-	Target     InvitationTarget  `json:"target"`
+	ID         string           `json:"id"`
+	AccountID  string           `json:"accountId"`
+	ProjectID  string           `json:"projectId"`
+	AcceptedAt time.Time        `json:"acceptedAt"`
+	Target     InvitationTarget `json:"target"`
 }
 
 // InvitationResult represents a complete invitation object
 type InvitationResult struct {
-	ID                       string                  `json:"id"`
-	AccountID                string                  `json:"accountId"`
-	ClickThroughs            int                     `json:"clickThroughs"`
-	ConfigurationAttributes  map[string]interface{}  `json:"configurationAttributes"`
-	Attributes               map[string]interface{}  `json:"attributes"`
-	CreatedAt                string                  `json:"createdAt"`
-	Deactivated              bool                    `json:"deactivated"`
-	DeliveryCount            int                     `json:"deliveryCount"`
-	DeliveryTypes            []string                `json:"deliveryTypes"`
-	ForeignCreatorID         string                  `json:"foreignCreatorId"`
-	InvitationType           string                  `json:"invitationType"`
-	ModifiedAt               *string                 `json:"modifiedAt"`
-	Status                   string                  `json:"status"`
-	Target                   []InvitationTarget      `json:"target"`
-	Views                    int                     `json:"views"`
-	WidgetConfigurationID    string                  `json:"widgetConfigurationId"`
-	DeploymentID             string                  `json:"deploymentId"`
-	ProjectID                string                  `json:"projectId"`
-	Groups                   []InvitationGroup       `json:"groups"`
-	Accepts                  []InvitationAcceptance  `json:"accepts"`
-	Scope                    *string                 `json:"scope,omitempty"`
-	ScopeType                *string                 `json:"scopeType,omitempty"`
-	Expired                  bool                    `json:"expired"`
-	Expires                  *string                 `json:"expires,omitempty"`
-	Metadata                 map[string]interface{}  `json:"metadata,omitempty"`
-	PassThrough              *string                 `json:"passThrough,omitempty"`
+	ID                      string                 `json:"id"`
+	AccountID               string                 `json:"accountId"`
+	ClickThroughs           int                    `json:"clickThroughs"`
+	ConfigurationAttributes map[string]interface{} `json:"configurationAttributes"`
+	Attributes              map[string]interface{} `json:"attributes"`
+	CreatedAt               time.Time              `json:"createdAt"`
+	Deactivated             bool                   `json:"deactivated"`
+	DeliveryCount           int                    `json:"deliveryCount"`
+	DeliveryTypes           []DeliveryType         `json:"deliveryTypes"`
+	ForeignCreatorID        string                 `json:"foreignCreatorId"`
+	InvitationType          string                 `json:"invitationType"`
+	ModifiedAt              *time.Time             `json:"modifiedAt"`
+	Status                  InvitationStatus       `json:"status"`
+	Target                  []InvitationTarget     `json:"target"`
+	Views                   int                    `json:"views"`
+	WidgetConfigurationID   string                 `json:"widgetConfigurationId"`
+	DeploymentID            string                 `json:"deploymentId"`
+	ProjectID               string                 `json:"projectId"`
+	Groups                  []InvitationGroup      `json:"groups"`
+	Accepts                 []InvitationAcceptance `json:"accepts"`
+	Scope                   *string                `json:"scope,omitempty"`
+	ScopeType               *string                `json:"scopeType,omitempty"`
+	Expired                 bool                   `json:"expired"`
+	Expires                 *time.Time             `json:"expires,omitempty"`
+	Metadata                map[string]interface{} `json:"metadata,omitempty"`
+	PassThrough             *string                `json:"passThrough,omitempty"`
+	DeepLink                *DeepLink              `json:"deepLink,omitempty"`
+	EffectivePolicy         *EffectivePolicy       `json:"effectivePolicy,omitempty"`
+	Tags                    []string               `json:"tags,omitempty"`
+	Archived                bool                   `json:"archived,omitempty"`
+	ArchivedAt              *time.Time             `json:"archivedAt,omitempty"`
+	VariantID               string                 `json:"variantId,omitempty"` // Delivery variant the invitation was sent with
+	Referral                *Referral              `json:"referral,omitempty"`
+	Version                 string                 `json:"version,omitempty"` // Changes on every update, for use with IfMatch
+	Locale                  string                 `json:"locale,omitempty"`  // Language the invitee receives emails in, e.g. "fr-CA"
+}
+
+// DeepLink configures how mobile apps open an invitation in-app
+type DeepLink struct {
+	IOS     *AppLink `json:"ios,omitempty"`
+	Android *AppLink `json:"android,omitempty"`
+	// FallbackURL is opened when the app is not installed
+	FallbackURL string `json:"fallbackUrl,omitempty"`
+}
+
+// AppLink is the deep link metadata for one mobile platform
+type AppLink struct {
+	AppScheme string `json:"appScheme"`          // URL scheme the app handles, e.g. "myapp://invite"
+	StoreURL  string `json:"storeUrl,omitempty"` // App Store or Play Store listing
+}
+
+// GroupRef identifies a customer group
+type GroupRef struct {
+	Type    string `json:"type"`
+	GroupID string `json:"groupId"`
+}
+
+// CreateInvitationRequest represents the request body for creating an
+// invitation
+type CreateInvitationRequest struct {
+	// WidgetConfigurationID selects the widget whose templates and settings
+	// the invitation uses
+	WidgetConfigurationID string             `json:"widgetConfigurationId"`
+	Targets               []InvitationTarget `json:"targets"`
+	// InviterID is your ID for the user sending the invitation
+	InviterID string     `json:"inviterId,omitempty"`
+	Groups    []GroupRef `json:"groups,omitempty"`
+	// DeliveryTypes are the channels to send on
+	DeliveryTypes []DeliveryType         `json:"deliveryTypes,omitempty"`
+	Attributes    map[string]interface{} `json:"attributes,omitempty"`
+	Expires       *time.Time             `json:"expires,omitempty"`
+	DeepLink      *DeepLink              `json:"deepLink,omitempty"`
+	// VariantID pins a delivery variant instead of letting the A/B test pick
+	VariantID string    `json:"variantId,omitempty"`
+	Locale    string    `json:"locale,omitempty"`
+	Referral  *Referral `json:"referral,omitempty"`
 }
 
 // AcceptInvitationRequest represents the request body for accepting invitations
 type AcceptInvitationRequest struct {
 	InvitationIDs []string         `json:"invitationIds"`
 	Target        InvitationTarget `json:"target"`
+	Actor         *Actor           `json:"actor,omitempty"`
+}
+
+// InvitationOverrides are the fields CloneInvitation changes on the copy;
+// anything left unset is copied from the original invitation
+type InvitationOverrides struct {
+	Target     InvitationTarget       `json:"target"`
+	Groups     []GroupRef             `json:"groups,omitempty"`
+	Attributes map[string]interface{} `json:"attributes,omitempty"`
+	Expires    *time.Time             `json:"expires,omitempty"`
+	Locale     string                 `json:"locale,omitempty"`
+}
+
+// Actor identifies an admin acting on behalf of an invitee
+type Actor struct {
+	ID    string `json:"id"`
+	Email string `json:"email,omitempty"`
 }
 
 // InvitationsResponse represents the API response containing multiple invitations
 type InvitationsResponse struct {
 	Invitations []InvitationResult `json:"invitations"`
+	// NextCursor and HasMore are set by paginated endpoints, see ListInvitations
+	NextCursor string `json:"nextCursor,omitempty"`
+	HasMore    bool   `json:"hasMore,omitempty"`
 }
 
 // JWTPayload represents the payload for JWT generation (legacy format)
 // Deprecated: Use JWTPayloadSimple for new implementations
 type JWTPayload struct {
-	UserID      string      `json:"userId"`
+	UserID      string       `json:"userId"`
 	Identifiers []Identifier `json:"identifiers"`
-	Groups      []Group     `json:"groups"`
-	Role        *string     `json:"role,omitempty"`
+	Groups      []Group      `json:"groups"`
+	Role        *string      `json:"role,omitempty"`
 }
 
 // JWTPayloadSimple represents the simplified JWT payload (recommended)
@@ -119,19 +196,68 @@ type JWTClaims struct {
 	UserID              string       `json:"userId"`
 	UserEmail           string       `json:"userEmail,omitempty"`
 	UserIsAutojoinAdmin *bool        `json:"userIsAutojoinAdmin,omitempty"`
+	AdminScopes         []string     `json:"adminScopes,omitempty"`
 	Groups              []Group      `json:"groups,omitempty"`
 	Role                *string      `json:"role,omitempty"`
 	Expires             int64        `json:"expires"`
+	NotBefore           int64        `json:"notBefore,omitempty"`
 	Identifiers         []Identifier `json:"identifiers,omitempty"`
 }
 
+// Errors matched by APIErrors with the corresponding status code, e.g.
+// errors.Is(err, ErrNotFound) for a 404; a 409 or 412 matches ErrConflict
+var (
+	// ErrNotFound matches 404 responses
+	ErrNotFound = errors.New("vortex: not found")
+	// ErrUnauthorized matches 401 and 403 responses: the API key is missing,
+	// invalid or not allowed to make the call
+	ErrUnauthorized = errors.New("vortex: unauthorized")
+	// ErrRateLimited matches 429 responses; APIError.RetryAfter says how long
+	// to wait
+	ErrRateLimited = errors.New("vortex: rate limited")
+)
+
 // APIError represents an error from the Vortex API
 type APIError struct {
 	StatusCode int    `json:"statusCode"`
 	Message    string `json:"message"`
 	Details    string `json:"details,omitempty"`
+	Method     string `json:"method,omitempty"`
+	Path       string `json:"path,omitempty"`
+	RequestID  string `json:"requestId,omitempty"`
+
+	// RetryAfter is how long the server asked callers to wait before trying
+	// again, from the Retry-After header
+	RetryAfter time.Duration `json:"retryAfter,omitempty"`
+
+	// RateLimit is the rate limit state reported with the response, if any
+	RateLimit *RateLimit `json:"rateLimit,omitempty"`
+
+	// cause is the parsed error body, e.g. a *ValidationError for a 422
+	cause error
 }
 
 func (e *APIError) Error() string {
 	return e.Message
-}
\ No newline at end of file
+}
+
+// Unwrap returns the error parsed from the response body, if any, so it can be
+// matched with errors.As
+func (e *APIError) Unwrap() error {
+	return e.cause
+}
+
+// Is makes errors.Is match the sentinel error for the status code
+func (e *APIError) Is(target error) bool {
+	switch e.StatusCode {
+	case http.StatusNotFound:
+		return target == ErrNotFound
+	case http.StatusUnauthorized, http.StatusForbidden:
+		return target == ErrUnauthorized
+	case http.StatusTooManyRequests:
+		return target == ErrRateLimited
+	case http.StatusConflict, http.StatusPreconditionFailed:
+		return target == ErrConflict
+	}
+	return false
+}
@@ -2,7 +2,12 @@ package vortex
 
 import (
 	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"testing"
+	"time"
 )
 
 // TestInvitationGroupDeserialization tests that all 6 fields from the API response
@@ -40,8 +45,8 @@ func TestInvitationGroupDeserialization(t *testing.T) {
 	if group.Name != "My Workspace" {
 		t.Errorf("Expected name to be 'My Workspace', got '%s'", group.Name)
 	}
-	if group.CreatedAt != "2025-01-27T12:00:00.000Z" {
-		t.Errorf("Expected createdAt to be '2025-01-27T12:00:00.000Z', got '%s'", group.CreatedAt)
+	if want := time.Date(2025, 1, 27, 12, 0, 0, 0, time.UTC); !group.CreatedAt.Equal(want) {
+		t.Errorf("Expected createdAt to be %v, got %v", want, group.CreatedAt)
 	}
 }
 
@@ -144,3 +149,78 @@ func TestGroupInputSerialization(t *testing.T) {
 		})
 	}
 }
+
+func TestInvitationResultDeepLink(t *testing.T) {
+	apiResponse := `{
+		"id": "inv-1",
+		"deepLink": {
+			"ios": {"appScheme": "myapp://invite", "storeUrl": "https://apps.apple.com/app/id123"},
+			"android": {"appScheme": "myapp://invite"},
+			"fallbackUrl": "https://example.com/invite"
+		}
+	}`
+
+	var invitation InvitationResult
+	if err := json.Unmarshal([]byte(apiResponse), &invitation); err != nil {
+		t.Fatalf("Failed to unmarshal InvitationResult: %v", err)
+	}
+
+	if invitation.DeepLink == nil {
+		t.Fatal("Expected deepLink to be present")
+	}
+	if invitation.DeepLink.IOS == nil || invitation.DeepLink.IOS.AppScheme != "myapp://invite" {
+		t.Errorf("Expected iOS appScheme 'myapp://invite', got %+v", invitation.DeepLink.IOS)
+	}
+	if invitation.DeepLink.Android == nil || invitation.DeepLink.Android.StoreURL != "" {
+		t.Errorf("Expected Android link without storeUrl, got %+v", invitation.DeepLink.Android)
+	}
+	if invitation.DeepLink.FallbackURL != "https://example.com/invite" {
+		t.Errorf("Expected fallbackUrl 'https://example.com/invite', got '%s'", invitation.DeepLink.FallbackURL)
+	}
+}
+
+func TestAPIErrorSentinels(t *testing.T) {
+	tests := []struct {
+		status int
+		want   error
+	}{
+		{404, ErrNotFound},
+		{401, ErrUnauthorized},
+		{403, ErrUnauthorized},
+		{429, ErrRateLimited},
+		{409, ErrConflict},
+		{412, ErrConflict},
+	}
+	sentinels := []error{ErrNotFound, ErrUnauthorized, ErrRateLimited, ErrConflict}
+
+	for _, tt := range tests {
+		err := fmt.Errorf("wrapped: %w", &APIError{StatusCode: tt.status})
+		for _, sentinel := range sentinels {
+			if got := errors.Is(err, sentinel); got != (sentinel == tt.want) {
+				t.Errorf("errors.Is(%d, %v) = %v", tt.status, sentinel, got)
+			}
+		}
+	}
+
+	if errors.Is(&APIError{StatusCode: 500}, ErrNotFound) {
+		t.Error("Expected a 500 not to match ErrNotFound")
+	}
+}
+
+func TestAPIErrorSentinelsFromClient(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	client := NewClientWithOptions("test-api-key", server.URL, nil)
+	_, err := client.GetInvitation("missing")
+	if !errors.Is(err, ErrNotFound) {
+		t.Errorf("Expected ErrNotFound, got %v", err)
+	}
+
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != 404 {
+		t.Errorf("Expected a 404 APIError, got %v", err)
+	}
+}
@@ -0,0 +1,68 @@
+package vortex
+
+import (
+	"encoding/json"
+	"sort"
+	"strings"
+)
+
+// ValidationError is the cause of a 422 APIError, listing the problems with
+// each invalid request field
+//
+// Example:
+//
+//	var validationErr *vortex.ValidationError
+//	if errors.As(err, &validationErr) {
+//	    for field, messages := range validationErr.Fields {
+//	        form.SetError(field, messages[0])
+//	    }
+//	}
+type ValidationError struct {
+	// Fields maps request field paths, e.g. "target.value", to their messages
+	Fields map[string][]string
+}
+
+func (e *ValidationError) Error() string {
+	fields := make([]string, 0, len(e.Fields))
+	for field := range e.Fields {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+
+	parts := make([]string, len(fields))
+	for i, field := range fields {
+		parts[i] = field + ": " + strings.Join(e.Fields[field], ", ")
+	}
+	return "validation failed: " + strings.Join(parts, "; ")
+}
+
+// parseValidationError reads the field errors from a 422 response body, which
+// lists them either as {"errors": {"field": ["message"]}} or as
+// {"errors": [{"field": "field", "message": "message"}]}
+func parseValidationError(body []byte) *ValidationError {
+	var response struct {
+		Errors json.RawMessage `json:"errors"`
+	}
+	if json.Unmarshal(body, &response) != nil || len(response.Errors) == 0 {
+		return nil
+	}
+
+	fields := make(map[string][]string)
+	var byField map[string][]string
+	var list []struct {
+		Field   string `json:"field"`
+		Message string `json:"message"`
+	}
+	if json.Unmarshal(response.Errors, &byField) == nil {
+		fields = byField
+	} else if json.Unmarshal(response.Errors, &list) == nil {
+		for _, entry := range list {
+			fields[entry.Field] = append(fields[entry.Field], entry.Message)
+		}
+	}
+
+	if len(fields) == 0 {
+		return nil
+	}
+	return &ValidationError{Fields: fields}
+}
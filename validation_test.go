@@ -0,0 +1,60 @@
+package vortex
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestValidationError(t *testing.T) {
+	bodies := map[string]string{
+		"map":  `{"message": "invalid request", "errors": {"target.value": ["is not a valid email"], "groups": ["is required"]}}`,
+		"list": `{"errors": [{"field": "target.value", "message": "is not a valid email"}, {"field": "groups", "message": "is required"}]}`,
+	}
+
+	for name, body := range bodies {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusUnprocessableEntity)
+				w.Write([]byte(body))
+			}))
+			defer server.Close()
+
+			client := NewClientWithOptions("test-api-key", server.URL, nil)
+			_, err := client.AcceptInvitations([]string{"inv1"}, InvitationTarget{Type: "email", Value: "nope"})
+
+			var apiErr *APIError
+			if !errors.As(err, &apiErr) || apiErr.StatusCode != 422 {
+				t.Fatalf("Expected a 422 APIError, got %v", err)
+			}
+
+			var validationErr *ValidationError
+			if !errors.As(err, &validationErr) {
+				t.Fatalf("Expected a ValidationError, got %v", err)
+			}
+			if messages := validationErr.Fields["target.value"]; len(messages) != 1 || messages[0] != "is not a valid email" {
+				t.Errorf("Expected target.value message, got %v", validationErr.Fields)
+			}
+			if validationErr.Error() != "validation failed: groups: is required; target.value: is not a valid email" {
+				t.Errorf("Unexpected message: %s", validationErr.Error())
+			}
+		})
+	}
+}
+
+func TestValidationError_Unparseable(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnprocessableEntity)
+		w.Write([]byte(`unprocessable`))
+	}))
+	defer server.Close()
+
+	client := NewClientWithOptions("test-api-key", server.URL, nil)
+	_, err := client.GetInvitation("inv1")
+
+	var validationErr *ValidationError
+	if errors.As(err, &validationErr) {
+		t.Errorf("Expected no ValidationError for an unparseable body, got %v", validationErr)
+	}
+}
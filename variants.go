@@ -0,0 +1,56 @@
+package vortex
+
+import (
+	"context"
+	"fmt"
+)
+
+// DeliveryVariant is one arm of a delivery A/B test
+type DeliveryVariant struct {
+	// ID names the variant, e.g. "a" or "short-subject"
+	ID      string `json:"id"`
+	Subject string `json:"subject"`
+	// Weight is the variant's relative share of deliveries; variants with
+	// equal weights are sent equally often
+	Weight int `json:"weight,omitempty"`
+}
+
+// VariantMetrics is the delivery performance of one variant
+type VariantMetrics struct {
+	VariantID  string  `json:"variantId"`
+	Sent       int     `json:"sent"`
+	Opened     int     `json:"opened"`
+	Clicked    int     `json:"clicked"`
+	Accepted   int     `json:"accepted"`
+	AcceptRate float64 `json:"acceptRate"`
+}
+
+// VariantMetricsResponse represents the API response for variant metrics
+type VariantMetricsResponse struct {
+	Variants []VariantMetrics `json:"variants"`
+}
+
+// GetVariantMetrics retrieves per-variant delivery metrics for the
+// invitations sent with a widget configuration
+//
+// Example:
+//
+//	metrics, err := client.GetVariantMetrics(ctx, widgetConfigurationID)
+//	for _, m := range metrics {
+//	    fmt.Printf("%s: %.1f%% accepted\n", m.VariantID, m.AcceptRate*100)
+//	}
+func (c *Client) GetVariantMetrics(ctx context.Context, widgetConfigurationID string, opts ...RequestOption) ([]VariantMetrics, error) {
+	path := fmt.Sprintf("/api/v1/widget-configurations/%s/variant-metrics", widgetConfigurationID)
+
+	responseBody, err := c.apiRequestContext(ctx, "GET", path, nil, nil, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	var response VariantMetricsResponse
+	if err := c.decode(responseBody, &response); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	return response.Variants, nil
+}
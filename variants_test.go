@@ -0,0 +1,45 @@
+package vortex
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestGetVariantMetrics(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "GET" || r.URL.Path != "/api/v1/widget-configurations/wc-1/variant-metrics" {
+			t.Errorf("Expected GET /api/v1/widget-configurations/wc-1/variant-metrics, got %s %s", r.Method, r.URL.Path)
+		}
+		w.Write([]byte(`{"variants": [
+			{"variantId": "a", "sent": 100, "opened": 60, "clicked": 30, "accepted": 20, "acceptRate": 0.2},
+			{"variantId": "b", "sent": 100, "opened": 70, "clicked": 40, "accepted": 25, "acceptRate": 0.25}
+		]}`))
+	}))
+	defer server.Close()
+
+	client := NewClientWithOptions("test-api-key", server.URL, nil)
+
+	metrics, err := client.GetVariantMetrics(context.Background(), "wc-1")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if len(metrics) != 2 {
+		t.Fatalf("Expected 2 variants, got %d", len(metrics))
+	}
+	if metrics[1].VariantID != "b" || metrics[1].AcceptRate != 0.25 {
+		t.Errorf("Unexpected metrics for variant b: %+v", metrics[1])
+	}
+}
+
+func TestInvitationResultVariant(t *testing.T) {
+	var invitation InvitationResult
+	if err := json.Unmarshal([]byte(`{"id": "inv-1", "variantId": "b"}`), &invitation); err != nil {
+		t.Fatalf("Failed to unmarshal InvitationResult: %v", err)
+	}
+	if invitation.VariantID != "b" {
+		t.Errorf("Expected variant 'b', got '%s'", invitation.VariantID)
+	}
+}
@@ -1,4 +1,4 @@
 package vortex
 
 // Version is the current version of the Vortex Go SDK
-const Version = "v1.1.1"
+const Version = "v1.2.0"
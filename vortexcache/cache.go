@@ -0,0 +1,299 @@
+// Package vortexcache mirrors Vortex invitations in a local store so that
+// read-heavy callers keep working through Vortex API latency spikes and outages.
+//
+// Reads are served from the store while entries are fresh. Stale entries are
+// returned immediately and refreshed in the background, and if the API fails
+// the last known value is returned instead of the error.
+//
+//	store := vortexcache.NewSQLStore(db, "vortex_cache", vortexcache.DialectPostgres)
+//	if err := store.CreateTable(ctx); err != nil {
+//	    log.Fatal(err)
+//	}
+//	cache := vortexcache.New(client, store, time.Minute)
+//	invitations, err := cache.GetInvitationsByGroup(ctx, "workspace", "ws-123")
+package vortexcache
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	vortex "github.com/TeamVortexSoftware/vortex-go-sdk"
+)
+
+// Entry is a value held by a Store
+type Entry struct {
+	Value    []byte
+	StoredAt time.Time
+}
+
+// Store persists cache entries
+//
+// Get returns a nil entry and nil error when the key is not present.
+type Store interface {
+	Get(ctx context.Context, key string) (*Entry, error)
+	Put(ctx context.Context, key string, value []byte) error
+	Delete(ctx context.Context, key string) error
+}
+
+// Cache serves invitation reads from a Store, refreshing them from the API
+type Cache struct {
+	client *vortex.Client
+	store  Store
+	ttl    time.Duration
+
+	// MaxStale is how long after expiring an entry may still be returned
+	// while it is refreshed in the background. Entries older than ttl+MaxStale
+	// are refreshed before returning. Defaults to 10 times the ttl.
+	MaxStale time.Duration
+
+	mu         sync.Mutex
+	refreshing map[string]bool
+
+	// invalidations counts InvalidateInvitation calls so that refreshes
+	// started before an invalidation do not store what they fetched
+	invalidations uint64
+}
+
+// New creates a cache in front of the client with entries fresh for ttl
+func New(client *vortex.Client, store Store, ttl time.Duration) *Cache {
+	return &Cache{
+		client:     client,
+		store:      store,
+		ttl:        ttl,
+		MaxStale:   10 * ttl,
+		refreshing: make(map[string]bool),
+	}
+}
+
+// GetInvitation retrieves a specific invitation by ID
+func (c *Cache) GetInvitation(ctx context.Context, invitationID string) (*vortex.InvitationResult, error) {
+	var invitation vortex.InvitationResult
+	err := c.load(ctx, invitationKey(invitationID), &invitation, func(ctx context.Context) (interface{}, error) {
+		return c.client.GetInvitationContext(ctx, invitationID)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &invitation, nil
+}
+
+// GetInvitationsByTarget retrieves invitations by target type and value
+func (c *Cache) GetInvitationsByTarget(ctx context.Context, targetType, targetValue string) ([]vortex.InvitationResult, error) {
+	var invitations []vortex.InvitationResult
+	err := c.load(ctx, targetKey(vortex.TargetType(targetType), targetValue), &invitations, func(ctx context.Context) (interface{}, error) {
+		return c.client.GetInvitationsByTargetContext(ctx, targetType, targetValue)
+	})
+	return invitations, err
+}
+
+// GetInvitationsByGroup retrieves invitations for a specific group
+func (c *Cache) GetInvitationsByGroup(ctx context.Context, groupType, groupID string) ([]vortex.InvitationResult, error) {
+	var invitations []vortex.InvitationResult
+	err := c.load(ctx, groupKey(groupType, groupID), &invitations, func(ctx context.Context) (interface{}, error) {
+		return c.client.GetInvitationsByGroupContext(ctx, groupType, groupID)
+	})
+	return invitations, err
+}
+
+// RevokeInvitation revokes an invitation and drops it from the cache
+func (c *Cache) RevokeInvitation(ctx context.Context, invitationID string) error {
+	if err := c.client.RevokeInvitationContext(ctx, invitationID); err != nil {
+		return err
+	}
+	return c.InvalidateInvitation(ctx, invitationID)
+}
+
+// AcceptInvitations accepts invitations and drops them from the cache
+func (c *Cache) AcceptInvitations(ctx context.Context, invitationIDs []string, target vortex.InvitationTarget) (*vortex.InvitationResult, error) {
+	result, err := c.client.AcceptInvitationsContext(ctx, invitationIDs, target)
+	if err != nil {
+		return nil, err
+	}
+	for _, id := range invitationIDs {
+		if err := c.InvalidateInvitation(ctx, id); err != nil {
+			return result, err
+		}
+	}
+	return result, c.store.Delete(ctx, targetKey(target.Type, target.Value))
+}
+
+// InvalidateInvitation drops a cached invitation along with the cached
+// target and group lists it appears in
+//
+// Lists are found through an index kept alongside them, so they are dropped
+// even when the invitation itself was never cached.
+func (c *Cache) InvalidateInvitation(ctx context.Context, invitationID string) error {
+	c.mu.Lock()
+	c.invalidations++
+	c.mu.Unlock()
+
+	key := invitationKey(invitationID)
+
+	index, err := c.store.Get(ctx, indexKey(invitationID))
+	if err != nil {
+		return err
+	}
+	if index != nil {
+		var listKeys []string
+		if json.Unmarshal(index.Value, &listKeys) == nil {
+			for _, listKey := range listKeys {
+				if err := c.store.Delete(ctx, listKey); err != nil {
+					return err
+				}
+			}
+		}
+		if err := c.store.Delete(ctx, indexKey(invitationID)); err != nil {
+			return err
+		}
+	}
+
+	entry, err := c.store.Get(ctx, key)
+	if err != nil {
+		return err
+	}
+	if entry != nil {
+		var invitation vortex.InvitationResult
+		if json.Unmarshal(entry.Value, &invitation) == nil {
+			for _, target := range invitation.Target {
+				if err := c.store.Delete(ctx, targetKey(target.Type, target.Value)); err != nil {
+					return err
+				}
+			}
+			for _, group := range invitation.Groups {
+				if err := c.store.Delete(ctx, groupKey(group.Type, group.GroupID)); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	return c.store.Delete(ctx, key)
+}
+
+// load decodes the cached value for key into out, fetching it when needed
+func (c *Cache) load(ctx context.Context, key string, out interface{}, fetch func(ctx context.Context) (interface{}, error)) error {
+	entry, err := c.store.Get(ctx, key)
+	if err != nil {
+		entry = nil
+	}
+
+	if entry != nil {
+		age := time.Since(entry.StoredAt)
+		if age < c.ttl+c.MaxStale {
+			if err := json.Unmarshal(entry.Value, out); err == nil {
+				if age >= c.ttl {
+					c.refreshInBackground(key, fetch)
+				}
+				return nil
+			}
+		}
+	}
+
+	value, fetchErr := c.refresh(ctx, key, fetch)
+	if fetchErr != nil {
+		// Fall back to the last known value rather than failing the read
+		if entry != nil && json.Unmarshal(entry.Value, out) == nil {
+			return nil
+		}
+		return fetchErr
+	}
+
+	if err := json.Unmarshal(value, out); err != nil {
+		return fmt.Errorf("failed to unmarshal cached value: %w", err)
+	}
+	return nil
+}
+
+// refresh fetches a value from the API and stores it
+func (c *Cache) refresh(ctx context.Context, key string, fetch func(ctx context.Context) (interface{}, error)) ([]byte, error) {
+	c.mu.Lock()
+	generation := c.invalidations
+	c.mu.Unlock()
+
+	result, err := fetch(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	value, err := json.Marshal(result)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal cache value: %w", err)
+	}
+
+	// Don't store a value that may predate an invalidation
+	c.mu.Lock()
+	stale := generation != c.invalidations
+	c.mu.Unlock()
+	if stale {
+		return value, nil
+	}
+
+	// A failed write only costs a later cache miss
+	if invitations, ok := result.([]vortex.InvitationResult); ok {
+		c.index(ctx, key, invitations)
+	}
+	c.store.Put(ctx, key, value)
+	return value, nil
+}
+
+// index records that the list stored under listKey contains each invitation
+func (c *Cache) index(ctx context.Context, listKey string, invitations []vortex.InvitationResult) {
+	for _, invitation := range invitations {
+		var listKeys []string
+		if entry, err := c.store.Get(ctx, indexKey(invitation.ID)); err == nil && entry != nil {
+			json.Unmarshal(entry.Value, &listKeys)
+		}
+
+		found := false
+		for _, k := range listKeys {
+			if k == listKey {
+				found = true
+				break
+			}
+		}
+		if found {
+			continue
+		}
+
+		value, _ := json.Marshal(append(listKeys, listKey))
+		c.store.Put(ctx, indexKey(invitation.ID), value)
+	}
+}
+
+func (c *Cache) refreshInBackground(key string, fetch func(ctx context.Context) (interface{}, error)) {
+	c.mu.Lock()
+	if c.refreshing[key] {
+		c.mu.Unlock()
+		return
+	}
+	c.refreshing[key] = true
+	c.mu.Unlock()
+
+	go func() {
+		defer func() {
+			c.mu.Lock()
+			delete(c.refreshing, key)
+			c.mu.Unlock()
+		}()
+		c.refresh(context.Background(), key, fetch)
+	}()
+}
+
+func invitationKey(invitationID string) string {
+	return "invitation:" + invitationID
+}
+
+func targetKey(targetType vortex.TargetType, targetValue string) string {
+	return "target:" + string(targetType) + ":" + targetValue
+}
+
+func indexKey(invitationID string) string {
+	return "index:" + invitationID
+}
+
+func groupKey(groupType, groupID string) string {
+	return "group:" + groupType + ":" + groupID
+}
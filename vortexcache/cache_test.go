@@ -0,0 +1,171 @@
+package vortexcache
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	vortex "github.com/TeamVortexSoftware/vortex-go-sdk"
+)
+
+func newTestServer(t *testing.T, requests *int32, failing *int32) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(requests, 1)
+		if atomic.LoadInt32(failing) == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+
+		invitation := vortex.InvitationResult{
+			ID:     "inv-1",
+			Status: "pending",
+			Target: []vortex.InvitationTarget{{Type: "email", Value: "test@example.com"}},
+		}
+
+		switch {
+		case r.Method == "GET" && r.URL.Path == "/api/v1/invitations":
+			json.NewEncoder(w).Encode(vortex.InvitationsResponse{Invitations: []vortex.InvitationResult{invitation}})
+		case r.Method == "GET":
+			json.NewEncoder(w).Encode(invitation)
+		case r.Method == "DELETE":
+			w.WriteHeader(http.StatusNoContent)
+		}
+	}))
+}
+
+func TestCache_GetInvitation(t *testing.T) {
+	var requests, failing int32
+	server := newTestServer(t, &requests, &failing)
+	defer server.Close()
+
+	client := vortex.NewClientWithOptions("test-api-key", server.URL, nil)
+	cache := New(client, NewMemoryStore(), time.Minute)
+	ctx := context.Background()
+
+	for i := 0; i < 3; i++ {
+		invitation, err := cache.GetInvitation(ctx, "inv-1")
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if invitation.ID != "inv-1" {
+			t.Errorf("Expected invitation ID to be 'inv-1', got %s", invitation.ID)
+		}
+	}
+
+	if n := atomic.LoadInt32(&requests); n != 1 {
+		t.Errorf("Expected 1 API request, got %d", n)
+	}
+}
+
+func TestCache_FallsBackToStaleEntry(t *testing.T) {
+	var requests, failing int32
+	server := newTestServer(t, &requests, &failing)
+	defer server.Close()
+
+	client := vortex.NewClientWithOptions("test-api-key", server.URL, nil)
+	store := NewMemoryStore()
+	cache := New(client, store, time.Minute)
+	cache.MaxStale = 0
+	ctx := context.Background()
+
+	if _, err := cache.GetInvitation(ctx, "inv-1"); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	// Age the entry past its ttl and take the API down
+	store.entries[invitationKey("inv-1")] = Entry{
+		Value:    store.entries[invitationKey("inv-1")].Value,
+		StoredAt: time.Now().Add(-time.Hour),
+	}
+	atomic.StoreInt32(&failing, 1)
+
+	invitation, err := cache.GetInvitation(ctx, "inv-1")
+	if err != nil {
+		t.Fatalf("Expected stale entry instead of error, got %v", err)
+	}
+	if invitation.ID != "inv-1" {
+		t.Errorf("Expected invitation ID to be 'inv-1', got %s", invitation.ID)
+	}
+	if n := atomic.LoadInt32(&requests); n != 2 {
+		t.Errorf("Expected 2 API requests, got %d", n)
+	}
+}
+
+func TestCache_RevokeInvalidates(t *testing.T) {
+	var requests, failing int32
+	server := newTestServer(t, &requests, &failing)
+	defer server.Close()
+
+	client := vortex.NewClientWithOptions("test-api-key", server.URL, nil)
+	store := NewMemoryStore()
+	cache := New(client, store, time.Minute)
+	ctx := context.Background()
+
+	if _, err := cache.GetInvitation(ctx, "inv-1"); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	store.Put(ctx, targetKey("email", "test@example.com"), []byte(`{"invitations":[]}`))
+
+	if err := cache.RevokeInvitation(ctx, "inv-1"); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if entry, _ := store.Get(ctx, invitationKey("inv-1")); entry != nil {
+		t.Error("Expected invitation to be removed from the cache")
+	}
+	if entry, _ := store.Get(ctx, targetKey("email", "test@example.com")); entry != nil {
+		t.Error("Expected target list to be removed from the cache")
+	}
+}
+
+func TestCache_RevokeInvalidatesUncachedInvitationLists(t *testing.T) {
+	var requests, failing int32
+	server := newTestServer(t, &requests, &failing)
+	defer server.Close()
+
+	client := vortex.NewClientWithOptions("test-api-key", server.URL, nil)
+	store := NewMemoryStore()
+	cache := New(client, store, time.Minute)
+	ctx := context.Background()
+
+	// Only the target list is cached, not the invitation itself
+	if _, err := cache.GetInvitationsByTarget(ctx, "email", "test@example.com"); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if err := cache.RevokeInvitation(ctx, "inv-1"); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if entry, _ := store.Get(ctx, targetKey("email", "test@example.com")); entry != nil {
+		t.Error("Expected target list to be removed from the cache")
+	}
+}
+
+func TestCache_RefreshDoesNotOverwriteInvalidation(t *testing.T) {
+	var requests, failing int32
+	server := newTestServer(t, &requests, &failing)
+	defer server.Close()
+
+	client := vortex.NewClientWithOptions("test-api-key", server.URL, nil)
+	store := NewMemoryStore()
+	cache := New(client, store, time.Minute)
+	ctx := context.Background()
+
+	// Simulate an invalidation landing while the fetch is in flight
+	_, err := cache.refresh(ctx, invitationKey("inv-1"), func(ctx context.Context) (interface{}, error) {
+		cache.InvalidateInvitation(ctx, "inv-1")
+		return client.GetInvitationContext(ctx, "inv-1")
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if entry, _ := store.Get(ctx, invitationKey("inv-1")); entry != nil {
+		t.Error("Expected refresh started before the invalidation not to be stored")
+	}
+}
@@ -0,0 +1,4 @@
+// Package sqlitetest tests vortexcache.SQLStore against SQLite. It is a
+// separate module so the root module does not depend on the cgo SQLite
+// driver.
+package sqlitetest
@@ -0,0 +1,12 @@
+module github.com/TeamVortexSoftware/vortex-go-sdk/vortexcache/sqlitetest
+
+go 1.18
+
+require (
+	github.com/TeamVortexSoftware/vortex-go-sdk v1.2.0
+	github.com/mattn/go-sqlite3 v1.14.6
+)
+
+require github.com/google/uuid v1.6.0 // indirect
+
+replace github.com/TeamVortexSoftware/vortex-go-sdk => ../../
@@ -0,0 +1,66 @@
+//go:build cgo
+
+package sqlitetest
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/TeamVortexSoftware/vortex-go-sdk/vortexcache"
+	_ "github.com/mattn/go-sqlite3"
+)
+
+func TestSQLStore(t *testing.T) {
+	db, err := sql.Open("sqlite3", ":memory:")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	defer db.Close()
+	db.SetMaxOpenConns(1)
+
+	store := vortexcache.NewSQLStore(db, "vortex_cache", vortexcache.DialectSQLite)
+	ctx := context.Background()
+
+	if err := store.CreateTable(ctx); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if err := store.CreateTable(ctx); err != nil {
+		t.Fatalf("Expected CreateTable to be idempotent, got %v", err)
+	}
+
+	entry, err := store.Get(ctx, "invitation:inv-1")
+	if err != nil || entry != nil {
+		t.Fatalf("Expected no entry, got %v, %v", entry, err)
+	}
+
+	if err := store.Put(ctx, "invitation:inv-1", []byte(`{"id":"inv-1"}`)); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	first, err := store.Get(ctx, "invitation:inv-1")
+	if err != nil || first == nil {
+		t.Fatalf("Expected entry, got %v, %v", first, err)
+	}
+
+	// Put on an existing key replaces the value
+	if err := store.Put(ctx, "invitation:inv-1", []byte(`{"id":"inv-1","status":"accepted"}`)); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	second, err := store.Get(ctx, "invitation:inv-1")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if string(second.Value) != `{"id":"inv-1","status":"accepted"}` {
+		t.Errorf("Expected updated value, got %s", second.Value)
+	}
+	if second.StoredAt.Before(first.StoredAt) {
+		t.Errorf("Expected StoredAt to move forward, got %v after %v", second.StoredAt, first.StoredAt)
+	}
+
+	if err := store.Delete(ctx, "invitation:inv-1"); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if entry, _ := store.Get(ctx, "invitation:inv-1"); entry != nil {
+		t.Error("Expected entry to be deleted")
+	}
+}
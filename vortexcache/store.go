@@ -0,0 +1,152 @@
+package vortexcache
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// Dialect selects the SQL syntax used by SQLStore
+type Dialect int
+
+const (
+	// DialectPostgres uses $n placeholders and ON CONFLICT upserts
+	DialectPostgres Dialect = iota
+	// DialectMySQL uses ? placeholders and ON DUPLICATE KEY upserts
+	DialectMySQL
+	// DialectSQLite uses ? placeholders and ON CONFLICT upserts
+	DialectSQLite
+)
+
+// SQLStore is a Store backed by a database/sql table
+//
+// The table holds one row per key with the JSON value and the time it was
+// stored; CreateTable creates it if it does not exist.
+type SQLStore struct {
+	db      *sql.DB
+	table   string
+	dialect Dialect
+}
+
+// NewSQLStore creates a store using the given table
+func NewSQLStore(db *sql.DB, table string, dialect Dialect) *SQLStore {
+	return &SQLStore{db: db, table: table, dialect: dialect}
+}
+
+// CreateTable creates the cache table if it does not exist
+func (s *SQLStore) CreateTable(ctx context.Context) error {
+	valueType := "BLOB"
+	switch s.dialect {
+	case DialectPostgres:
+		valueType = "BYTEA"
+	case DialectMySQL:
+		valueType = "LONGBLOB"
+	}
+
+	query := fmt.Sprintf(
+		"CREATE TABLE IF NOT EXISTS %s (cache_key VARCHAR(512) PRIMARY KEY, value %s NOT NULL, stored_at BIGINT NOT NULL)",
+		s.table, valueType,
+	)
+	if _, err := s.db.ExecContext(ctx, query); err != nil {
+		return fmt.Errorf("failed to create cache table: %w", err)
+	}
+	return nil
+}
+
+// Get implements Store
+func (s *SQLStore) Get(ctx context.Context, key string) (*Entry, error) {
+	query := fmt.Sprintf("SELECT value, stored_at FROM %s WHERE cache_key = %s", s.table, s.placeholder(1))
+
+	var value []byte
+	var storedAt int64
+	err := s.db.QueryRowContext(ctx, query, key).Scan(&value, &storedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read cache entry: %w", err)
+	}
+
+	return &Entry{Value: value, StoredAt: time.Unix(0, storedAt)}, nil
+}
+
+// Put implements Store
+func (s *SQLStore) Put(ctx context.Context, key string, value []byte) error {
+	var query string
+	if s.dialect == DialectMySQL {
+		query = fmt.Sprintf(
+			"INSERT INTO %s (cache_key, value, stored_at) VALUES (?, ?, ?) ON DUPLICATE KEY UPDATE value = VALUES(value), stored_at = VALUES(stored_at)",
+			s.table,
+		)
+	} else {
+		query = fmt.Sprintf(
+			"INSERT INTO %s (cache_key, value, stored_at) VALUES (%s, %s, %s) ON CONFLICT (cache_key) DO UPDATE SET value = excluded.value, stored_at = excluded.stored_at",
+			s.table, s.placeholder(1), s.placeholder(2), s.placeholder(3),
+		)
+	}
+
+	if _, err := s.db.ExecContext(ctx, query, key, value, time.Now().UnixNano()); err != nil {
+		return fmt.Errorf("failed to write cache entry: %w", err)
+	}
+	return nil
+}
+
+// Delete implements Store
+func (s *SQLStore) Delete(ctx context.Context, key string) error {
+	query := fmt.Sprintf("DELETE FROM %s WHERE cache_key = %s", s.table, s.placeholder(1))
+	if _, err := s.db.ExecContext(ctx, query, key); err != nil {
+		return fmt.Errorf("failed to delete cache entry: %w", err)
+	}
+	return nil
+}
+
+func (s *SQLStore) placeholder(n int) string {
+	if s.dialect == DialectPostgres {
+		return fmt.Sprintf("$%d", n)
+	}
+	return "?"
+}
+
+// MemoryStore is an in-process Store, mainly useful for tests
+type MemoryStore struct {
+	mu      sync.Mutex
+	entries map[string]Entry
+}
+
+// NewMemoryStore creates an empty in-process store
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{entries: make(map[string]Entry)}
+}
+
+// Get implements Store
+func (s *MemoryStore) Get(ctx context.Context, key string) (*Entry, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	entry, ok := s.entries[key]
+	if !ok {
+		return nil, nil
+	}
+	return &entry, nil
+}
+
+// Put implements Store
+func (s *MemoryStore) Put(ctx context.Context, key string, value []byte) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.entries[key] = Entry{Value: value, StoredAt: time.Now()}
+	return nil
+}
+
+// Delete implements Store
+func (s *MemoryStore) Delete(ctx context.Context, key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.entries, key)
+	return nil
+}
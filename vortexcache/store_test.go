@@ -0,0 +1,16 @@
+package vortexcache
+
+import "testing"
+
+func TestSQLStore_Placeholders(t *testing.T) {
+	tests := map[Dialect]string{
+		DialectPostgres: "$2",
+		DialectMySQL:    "?",
+		DialectSQLite:   "?",
+	}
+	for dialect, expected := range tests {
+		if got := NewSQLStore(nil, "vortex_cache", dialect).placeholder(2); got != expected {
+			t.Errorf("Expected placeholder %s for dialect %d, got %s", expected, dialect, got)
+		}
+	}
+}
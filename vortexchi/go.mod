@@ -0,0 +1,12 @@
+module github.com/TeamVortexSoftware/vortex-go-sdk/vortexchi
+
+go 1.18
+
+require (
+	github.com/TeamVortexSoftware/vortex-go-sdk v1.2.0
+	github.com/go-chi/chi/v5 v5.0.12
+)
+
+require github.com/google/uuid v1.6.0 // indirect
+
+replace github.com/TeamVortexSoftware/vortex-go-sdk => ../
@@ -0,0 +1,29 @@
+// Package vortexchi mounts the Vortex widget's token endpoint and Vortex
+// webhooks on a chi router.
+//
+//	r := chi.NewRouter()
+//	r.Mount("/vortex", vortexchi.Routes(client, userFromSession, &vortexwebhooks.Handler{
+//	    Secret: os.Getenv("VORTEX_WEBHOOK_SECRET"),
+//	    Handle: handleEvent,
+//	}, vortex.AllowOrigins("https://app.example.com")))
+//
+// chi handlers are plain http.Handlers, so vortex.TokenHandler and
+// vortexwebhooks.Handler can also be routed individually.
+package vortexchi
+
+import (
+	vortex "github.com/TeamVortexSoftware/vortex-go-sdk"
+	"github.com/TeamVortexSoftware/vortex-go-sdk/vortexwebhooks"
+	"github.com/go-chi/chi/v5"
+)
+
+// Routes returns a router serving widget tokens at /token and, when webhooks
+// is not nil, receiving webhooks at POST /webhooks
+func Routes(client *vortex.Client, userFromRequest vortex.UserFromRequestFunc, webhooks *vortexwebhooks.Handler, opts ...vortex.TokenHandlerOption) chi.Router {
+	r := chi.NewRouter()
+	r.Handle("/token", vortex.TokenHandler(client, userFromRequest, opts...))
+	if webhooks != nil {
+		r.Method("POST", "/webhooks", webhooks)
+	}
+	return r
+}
@@ -0,0 +1,252 @@
+// Package vortexcompat mirrors the Vortex Node SDK's method names and payload
+// shapes, so Node services can be ported to Go line by line.
+//
+//	// const vortex = new Vortex(process.env.VORTEX_API_KEY)
+//	v := vortexcompat.New(os.Getenv("VORTEX_API_KEY"))
+//	// const jwt = vortex.generateJwt({ user: { id, email }, role: 'admin' })
+//	jwt, err := v.GenerateJwtJSON([]byte(`{"user": {"id": "user-123", "email": "user@example.com"}, "role": "admin"}`))
+//	// await vortex.getInvitationsByTarget('email', 'user@example.com')
+//	invitations, err := v.GetInvitationsByTarget("email", "user@example.com")
+//
+// Tokens are built with the Node SDK's claim order and JSON encoding, so with
+// the same key, clock and params they match its output byte for byte. Use
+// Client for anything the Node SDK has no equivalent of.
+package vortexcompat
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+
+	vortex "github.com/TeamVortexSoftware/vortex-go-sdk"
+	"github.com/TeamVortexSoftware/vortex-go-sdk/vortexjwt"
+)
+
+// jwtTTL matches the expiry the Node SDK puts on generated JWTs
+const jwtTTL = time.Hour
+
+// Vortex is the equivalent of the Node SDK's Vortex class
+type Vortex struct {
+	// Client is the Go client the calls are made with
+	Client *vortex.Client
+	// Now returns the time tokens are issued at; override it to reproduce a
+	// token generated by the Node SDK
+	Now func() time.Time
+
+	apiKey string
+}
+
+// New is the equivalent of `new Vortex(apiKey)`, including its
+// VORTEX_API_BASE_URL override
+func New(apiKey string) *Vortex {
+	return &Vortex{Client: vortex.NewClient(apiKey), Now: time.Now, apiKey: apiKey}
+}
+
+// NewFromConfig creates a Vortex from the JSON configuration object used with
+// the Node SDK, e.g. {"apiKey": "VRTX....", "baseUrl": "https://..."}
+func NewFromConfig(data []byte) (*Vortex, error) {
+	config, err := vortex.ParseNodeConfig(data)
+	if err != nil {
+		return nil, err
+	}
+	return &Vortex{Client: config.NewClient(), Now: time.Now, apiKey: config.APIKey}, nil
+}
+
+// GenerateJwtParams is the object passed to generateJwt: the user plus any
+// other properties, which are added to the token as claims
+type GenerateJwtParams struct {
+	User       vortex.User
+	Attributes map[string]interface{}
+}
+
+// GenerateJwt is the equivalent of generateJwt. Attributes are added in
+// alphabetical order; use GenerateJwtJSON to keep the order of a Node object
+// literal.
+func (v *Vortex) GenerateJwt(params GenerateJwtParams) (string, error) {
+	keys := make([]string, 0, len(params.Attributes))
+	for key := range params.Attributes {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	extra := make([]claim, 0, len(keys))
+	for _, key := range keys {
+		value, err := encode(params.Attributes[key])
+		if err != nil {
+			return "", fmt.Errorf("failed to marshal JWT property %q: %w", key, err)
+		}
+		extra = append(extra, claim{key, value})
+	}
+	return v.sign(&params.User, extra)
+}
+
+// GenerateJwtJSON is the equivalent of generateJwt called with the given JSON
+// object, in either the current {"user": {...}, ...} shape or the older flat
+// {"userId": ..., "userEmail": ...} shape. Properties keep their order.
+func (v *Vortex) GenerateJwtJSON(data []byte) (string, error) {
+	user, _, err := vortex.ParseNodeJWTParams(data)
+	if err != nil {
+		return "", err
+	}
+
+	properties, err := orderedProperties(data)
+	if err != nil {
+		return "", fmt.Errorf("failed to unmarshal Node SDK JWT params: %w", err)
+	}
+
+	userKeys := map[string]bool{"userId": true, "userEmail": true, "userIsAutojoinAdmin": true}
+	for _, property := range properties {
+		if property.key == "user" {
+			userKeys = map[string]bool{"user": true}
+			break
+		}
+	}
+
+	var extra []claim
+	for _, property := range properties {
+		if !userKeys[property.key] {
+			extra = append(extra, property)
+		}
+	}
+	return v.sign(user, extra)
+}
+
+// GetInvitationsByTarget is the equivalent of getInvitationsByTarget
+func (v *Vortex) GetInvitationsByTarget(targetType, targetValue string) ([]vortex.InvitationResult, error) {
+	return v.Client.GetInvitationsByTarget(targetType, targetValue)
+}
+
+// GetInvitation is the equivalent of getInvitation
+func (v *Vortex) GetInvitation(invitationID string) (*vortex.InvitationResult, error) {
+	return v.Client.GetInvitation(invitationID)
+}
+
+// RevokeInvitation is the equivalent of revokeInvitation
+func (v *Vortex) RevokeInvitation(invitationID string) error {
+	return v.Client.RevokeInvitation(invitationID)
+}
+
+// AcceptInvitations is the equivalent of acceptInvitations
+func (v *Vortex) AcceptInvitations(invitationIDs []string, target vortex.InvitationTarget) (*vortex.InvitationResult, error) {
+	return v.Client.AcceptInvitations(invitationIDs, target)
+}
+
+// DeleteInvitationsByGroup is the equivalent of deleteInvitationsByGroup
+func (v *Vortex) DeleteInvitationsByGroup(groupType, groupID string) error {
+	return v.Client.DeleteInvitationsByGroup(groupType, groupID)
+}
+
+// GetInvitationsByGroup is the equivalent of getInvitationsByGroup
+func (v *Vortex) GetInvitationsByGroup(groupType, groupID string) ([]vortex.InvitationResult, error) {
+	return v.Client.GetInvitationsByGroup(groupType, groupID)
+}
+
+// Reinvite is the equivalent of reinvite
+func (v *Vortex) Reinvite(invitationID string) (*vortex.InvitationResult, error) {
+	return v.Client.Reinvite(invitationID)
+}
+
+// claim is a JWT payload property with its encoded value
+type claim struct {
+	key   string
+	value json.RawMessage
+}
+
+// sign builds the payload in the Node SDK's order: userId, userEmail,
+// expires, adminScopes, then the other properties. Like a JavaScript object
+// spread, a property named after one of the first four replaces its value in
+// place.
+func (v *Vortex) sign(user *vortex.User, extra []claim) (string, error) {
+	key, err := vortexjwt.ParseAPIKey(v.apiKey)
+	if err != nil {
+		return "", err
+	}
+
+	now := v.Now()
+	payload := []claim{
+		{"userId", mustEncode(user.ID)},
+		{"userEmail", mustEncode(user.Email)},
+		{"expires", mustEncode(now.Add(jwtTTL).Unix())},
+	}
+	if user.AdminScopes != nil {
+		payload = append(payload, claim{"adminScopes", mustEncode(user.AdminScopes)})
+	}
+
+	for _, property := range extra {
+		replaced := false
+		for i := range payload {
+			if payload[i].key == property.key {
+				payload[i].value = property.value
+				replaced = true
+			}
+		}
+		if !replaced {
+			payload = append(payload, property)
+		}
+	}
+
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	for i, property := range payload {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		buf.Write(mustEncode(property.key))
+		buf.WriteByte(':')
+		buf.Write(property.value)
+	}
+	buf.WriteByte('}')
+
+	return key.SignJSON(buf.Bytes(), now)
+}
+
+// encode marshals v the way JSON.stringify does, without escaping HTML
+// characters
+func encode(v interface{}) (json.RawMessage, error) {
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	encoder.SetEscapeHTML(false)
+	if err := encoder.Encode(v); err != nil {
+		return nil, err
+	}
+	return bytes.TrimSuffix(buf.Bytes(), []byte("\n")), nil
+}
+
+// mustEncode encodes values that always marshal: strings, numbers and
+// string slices
+func mustEncode(v interface{}) json.RawMessage {
+	encoded, err := encode(v)
+	if err != nil {
+		panic(err)
+	}
+	return encoded
+}
+
+// orderedProperties returns the properties of a JSON object in the order they
+// appear, with their values compacted
+func orderedProperties(data []byte) ([]claim, error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	if _, err := decoder.Token(); err != nil {
+		return nil, err
+	}
+
+	var properties []claim
+	for decoder.More() {
+		token, err := decoder.Token()
+		if err != nil {
+			return nil, err
+		}
+		var raw json.RawMessage
+		if err := decoder.Decode(&raw); err != nil {
+			return nil, err
+		}
+		var compact bytes.Buffer
+		if err := json.Compact(&compact, raw); err != nil {
+			return nil, err
+		}
+		properties = append(properties, claim{token.(string), compact.Bytes()})
+	}
+	return properties, nil
+}
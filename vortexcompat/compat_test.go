@@ -0,0 +1,126 @@
+package vortexcompat
+
+import (
+	"encoding/base64"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	vortex "github.com/TeamVortexSoftware/vortex-go-sdk"
+)
+
+const testAPIKey = "VRTX.EjRWeBI0EjQSNBI0VniQEg.test-key"
+
+func payload(t *testing.T, token string) string {
+	t.Helper()
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		t.Fatalf("Expected 3 JWT parts, got %d", len(parts))
+	}
+	decoded, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		t.Fatalf("Failed to decode payload: %v", err)
+	}
+	return string(decoded)
+}
+
+func fixedClock() time.Time {
+	return time.Unix(1700000000, 0)
+}
+
+func TestGenerateJwtJSON(t *testing.T) {
+	v := New(testAPIKey)
+	v.Now = fixedClock
+
+	tests := []struct {
+		name   string
+		params string
+		want   string
+	}{
+		{
+			"user shape",
+			`{"user": {"id": "user-123", "email": "user@example.com", "adminScopes": ["autojoin"]}, "role": "admin", "team": {"name": "R&D", "size": 3}}`,
+			`{"userId":"user-123","userEmail":"user@example.com","expires":1700003600,"adminScopes":["autojoin"],"role":"admin","team":{"name":"R&D","size":3}}`,
+		},
+		{
+			"legacy shape",
+			`{"userId": "user-123", "userEmail": "user@example.com", "plan": "pro"}`,
+			`{"userId":"user-123","userEmail":"user@example.com","expires":1700003600,"plan":"pro"}`,
+		},
+		{
+			"override in place",
+			`{"user": {"id": "user-123", "email": "user@example.com"}, "expires": 1}`,
+			`{"userId":"user-123","userEmail":"user@example.com","expires":1}`,
+		},
+	}
+	for _, tt := range tests {
+		token, err := v.GenerateJwtJSON([]byte(tt.params))
+		if err != nil {
+			t.Fatalf("%s: Expected no error, got %v", tt.name, err)
+		}
+		if got := payload(t, token); got != tt.want {
+			t.Errorf("%s: Expected payload %s, got %s", tt.name, tt.want, got)
+		}
+	}
+
+	if _, err := v.GenerateJwtJSON([]byte(`{"role": "admin"}`)); err == nil {
+		t.Error("Expected error for params without a user")
+	}
+}
+
+func TestGenerateJwt(t *testing.T) {
+	v := New(testAPIKey)
+	v.Now = fixedClock
+
+	token, err := v.GenerateJwt(GenerateJwtParams{
+		User:       vortex.User{ID: "user-123", Email: "user@example.com"},
+		Attributes: map[string]interface{}{"team": "core", "role": "admin"},
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	want := `{"userId":"user-123","userEmail":"user@example.com","expires":1700003600,"role":"admin","team":"core"}`
+	if got := payload(t, token); got != want {
+		t.Errorf("Expected payload %s, got %s", want, got)
+	}
+
+	// Tokens from the compat layer verify with the regular client
+	v.Now = time.Now
+	token, err = v.GenerateJwt(GenerateJwtParams{User: vortex.User{ID: "user-123"}})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	claims, err := v.Client.VerifyJWT(token)
+	if err != nil {
+		t.Fatalf("Expected token to verify, got %v", err)
+	}
+	if claims.UserID != "user-123" {
+		t.Errorf("Expected user ID user-123, got %s", claims.UserID)
+	}
+}
+
+func TestNewFromConfig(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v1/invitations" || r.URL.Query().Get("targetValue") != "user@example.com" {
+			t.Errorf("Unexpected request %s", r.URL)
+		}
+		w.Write([]byte(`{"invitations": [{"id": "inv-123"}]}`))
+	}))
+	defer server.Close()
+
+	v, err := NewFromConfig([]byte(`{"apiKey": "` + testAPIKey + `", "baseUrl": "` + server.URL + `"}`))
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	invitations, err := v.GetInvitationsByTarget("email", "user@example.com")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if len(invitations) != 1 || invitations[0].ID != "inv-123" {
+		t.Errorf("Expected invitation inv-123, got %+v", invitations)
+	}
+}
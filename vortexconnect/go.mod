@@ -0,0 +1,13 @@
+module github.com/TeamVortexSoftware/vortex-go-sdk/vortexconnect
+
+go 1.20
+
+require (
+	connectrpc.com/connect v1.16.1
+	github.com/TeamVortexSoftware/vortex-go-sdk v1.2.0
+	google.golang.org/protobuf v1.33.0
+)
+
+require github.com/google/uuid v1.6.0 // indirect
+
+replace github.com/TeamVortexSoftware/vortex-go-sdk => ../
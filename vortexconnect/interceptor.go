@@ -0,0 +1,173 @@
+// Package vortexconnect provides connect-go interceptors that attach Vortex
+// JWTs to outgoing RPCs and verify them on incoming RPCs.
+//
+// Clients mint a token for the user stored in the call context with
+// vortex.ContextWithUser:
+//
+//	client := pingv1connect.NewPingServiceClient(httpClient, url,
+//	    connect.WithInterceptors(vortexconnect.NewClientInterceptor(vortexClient)))
+//	res, err := client.Ping(vortex.ContextWithUser(ctx, user), req)
+//
+// Handlers verify the token and expose its claims:
+//
+//	path, handler := pingv1connect.NewPingServiceHandler(svc,
+//	    connect.WithInterceptors(vortexconnect.NewHandlerInterceptor(vortexClient)))
+//
+//	func (s *svc) Ping(ctx context.Context, req *connect.Request[pingv1.PingRequest]) (...) {
+//	    claims, _ := vortexconnect.ClaimsFromContext(ctx)
+//	}
+package vortexconnect
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"connectrpc.com/connect"
+	vortex "github.com/TeamVortexSoftware/vortex-go-sdk"
+)
+
+type claimsContextKey struct{}
+
+// ClaimsFromContext returns the claims verified by the handler interceptor
+func ClaimsFromContext(ctx context.Context) (*vortex.JWTClaims, bool) {
+	claims, ok := ctx.Value(claimsContextKey{}).(*vortex.JWTClaims)
+	return claims, ok
+}
+
+// ClientOption configures the client interceptor
+type ClientOption func(*vortex.TokenTransport)
+
+// WithTokenCache shares minted tokens through cache, e.g. a
+// vortexredis.TokenCache; an in-memory cache is used by default
+func WithTokenCache(cache vortex.TokenCache) ClientOption {
+	return func(t *vortex.TokenTransport) {
+		t.Cache = cache
+	}
+}
+
+// NewClientInterceptor attaches a JWT for the user in the call context as a
+// bearer token; calls without a user are sent unchanged
+//
+// Tokens are cached per user like vortex.TokenTransport does, so they are
+// only minted shortly before the previous one expires.
+func NewClientInterceptor(client *vortex.Client, opts ...ClientOption) connect.Interceptor {
+	tokens := vortex.NewTokenTransport(client, nil)
+	for _, opt := range opts {
+		opt(tokens)
+	}
+	return &clientInterceptor{tokens: tokens}
+}
+
+type clientInterceptor struct {
+	tokens *vortex.TokenTransport
+}
+
+func (i *clientInterceptor) token(ctx context.Context) (string, error) {
+	user, ok := vortex.UserFromContext(ctx)
+	if !ok {
+		return "", nil
+	}
+	return i.tokens.Token(user)
+}
+
+func (i *clientInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		token, err := i.token(ctx)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
+		if token != "" {
+			req.Header().Set("Authorization", "Bearer "+token)
+		}
+		return next(ctx, req)
+	}
+}
+
+func (i *clientInterceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return func(ctx context.Context, spec connect.Spec) connect.StreamingClientConn {
+		conn := next(ctx, spec)
+		// Errors surface when the stream is used; an unsigned call is rejected
+		// by the server
+		if token, err := i.token(ctx); err == nil && token != "" {
+			conn.RequestHeader().Set("Authorization", "Bearer "+token)
+		}
+		return conn
+	}
+}
+
+func (i *clientInterceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return next
+}
+
+// errInvalidToken is returned to callers whose token fails verification; the
+// reason is only passed to the error handler
+var errInvalidToken = errors.New("invalid bearer token")
+
+// HandlerOption configures the handler interceptor
+type HandlerOption func(*handlerInterceptor)
+
+// WithErrorHandler is called with the reason a token was rejected, e.g. to
+// log it; callers only see a generic CodeUnauthenticated error
+func WithErrorHandler(handler func(ctx context.Context, err error)) HandlerOption {
+	return func(i *handlerInterceptor) {
+		i.onError = handler
+	}
+}
+
+// NewHandlerInterceptor rejects calls without a valid JWT with
+// CodeUnauthenticated and stores the verified claims in the handler context
+func NewHandlerInterceptor(client *vortex.Client, opts ...HandlerOption) connect.Interceptor {
+	i := &handlerInterceptor{client: client}
+	for _, opt := range opts {
+		opt(i)
+	}
+	return i
+}
+
+type handlerInterceptor struct {
+	client  *vortex.Client
+	onError func(ctx context.Context, err error)
+}
+
+func (i *handlerInterceptor) verify(ctx context.Context, authorization string) (context.Context, error) {
+	if len(authorization) <= 7 || !strings.EqualFold(authorization[:7], "Bearer ") {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("missing bearer token"))
+	}
+
+	claims, err := i.client.VerifyJWT(strings.TrimSpace(authorization[7:]))
+	if err != nil {
+		if i.onError != nil {
+			i.onError(ctx, err)
+		}
+		return nil, connect.NewError(connect.CodeUnauthenticated, errInvalidToken)
+	}
+	return context.WithValue(ctx, claimsContextKey{}, claims), nil
+}
+
+func (i *handlerInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		if req.Spec().IsClient {
+			return next(ctx, req)
+		}
+		ctx, err := i.verify(ctx, req.Header().Get("Authorization"))
+		if err != nil {
+			return nil, err
+		}
+		return next(ctx, req)
+	}
+}
+
+func (i *handlerInterceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return next
+}
+
+func (i *handlerInterceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return func(ctx context.Context, conn connect.StreamingHandlerConn) error {
+		ctx, err := i.verify(ctx, conn.RequestHeader().Get("Authorization"))
+		if err != nil {
+			return err
+		}
+		return next(ctx, conn)
+	}
+}
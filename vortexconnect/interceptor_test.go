@@ -0,0 +1,133 @@
+package vortexconnect
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	vortex "github.com/TeamVortexSoftware/vortex-go-sdk"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+const procedure = "/test.v1.TestService/WhoAmI"
+
+func newTestServer(client *vortex.Client, opts ...HandlerOption) *httptest.Server {
+	mux := http.NewServeMux()
+	mux.Handle(procedure, connect.NewUnaryHandler(procedure,
+		func(ctx context.Context, req *connect.Request[wrapperspb.StringValue]) (*connect.Response[wrapperspb.StringValue], error) {
+			claims, ok := ClaimsFromContext(ctx)
+			if !ok {
+				return nil, connect.NewError(connect.CodeInternal, errors.New("missing claims"))
+			}
+			return connect.NewResponse(wrapperspb.String(claims.UserID)), nil
+		},
+		connect.WithInterceptors(NewHandlerInterceptor(client, opts...)),
+	))
+	return httptest.NewServer(mux)
+}
+
+func TestInterceptors(t *testing.T) {
+	client := vortex.NewClient("VRTX.EjRWeBI0EjQSNBI0VniQEg.test-key")
+	server := newTestServer(client)
+	defer server.Close()
+
+	rpc := connect.NewClient[wrapperspb.StringValue, wrapperspb.StringValue](
+		server.Client(), server.URL+procedure,
+		connect.WithInterceptors(NewClientInterceptor(client)),
+	)
+
+	ctx := vortex.ContextWithUser(context.Background(), &vortex.User{ID: "user-123", Email: "test@example.com"})
+	res, err := rpc.CallUnary(ctx, connect.NewRequest(wrapperspb.String("")))
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if res.Msg.Value != "user-123" {
+		t.Errorf("Expected 'user-123', got %q", res.Msg.Value)
+	}
+}
+
+func TestHandlerInterceptor_Unauthenticated(t *testing.T) {
+	client := vortex.NewClient("VRTX.EjRWeBI0EjQSNBI0VniQEg.test-key")
+	server := newTestServer(client)
+	defer server.Close()
+
+	// No user in the context, so no token is attached
+	rpc := connect.NewClient[wrapperspb.StringValue, wrapperspb.StringValue](
+		server.Client(), server.URL+procedure,
+		connect.WithInterceptors(NewClientInterceptor(client)),
+	)
+
+	_, err := rpc.CallUnary(context.Background(), connect.NewRequest(wrapperspb.String("")))
+	if connect.CodeOf(err) != connect.CodeUnauthenticated {
+		t.Errorf("Expected CodeUnauthenticated, got %v", err)
+	}
+}
+
+func TestHandlerInterceptor_HidesVerificationError(t *testing.T) {
+	client := vortex.NewClient("VRTX.EjRWeBI0EjQSNBI0VniQEg.test-key")
+	other := vortex.NewClient("VRTX.EjRWeBI0EjQSNBI0VniQEg.other-key")
+
+	var reason error
+	server := newTestServer(client, WithErrorHandler(func(ctx context.Context, err error) { reason = err }))
+	defer server.Close()
+
+	rpc := connect.NewClient[wrapperspb.StringValue, wrapperspb.StringValue](
+		server.Client(), server.URL+procedure,
+		connect.WithInterceptors(NewClientInterceptor(other)),
+	)
+
+	ctx := vortex.ContextWithUser(context.Background(), &vortex.User{ID: "user-123"})
+	_, err := rpc.CallUnary(ctx, connect.NewRequest(wrapperspb.String("")))
+
+	var connectErr *connect.Error
+	if !errors.As(err, &connectErr) || connectErr.Code() != connect.CodeUnauthenticated {
+		t.Fatalf("Expected CodeUnauthenticated, got %v", err)
+	}
+	if connectErr.Message() != errInvalidToken.Error() {
+		t.Errorf("Expected generic message, got %q", connectErr.Message())
+	}
+	if reason == nil {
+		t.Error("Expected the error handler to receive the verification error")
+	}
+}
+
+type countingTokenCache struct {
+	tokens map[string]string
+	sets   int
+}
+
+func (c *countingTokenCache) Get(key string) (string, bool) {
+	token, ok := c.tokens[key]
+	return token, ok
+}
+
+func (c *countingTokenCache) Set(key, token string, ttl time.Duration) {
+	c.sets++
+	c.tokens[key] = token
+}
+
+func TestClientInterceptor_CachesTokens(t *testing.T) {
+	client := vortex.NewClient("VRTX.EjRWeBI0EjQSNBI0VniQEg.test-key")
+	server := newTestServer(client)
+	defer server.Close()
+
+	cache := &countingTokenCache{tokens: make(map[string]string)}
+	rpc := connect.NewClient[wrapperspb.StringValue, wrapperspb.StringValue](
+		server.Client(), server.URL+procedure,
+		connect.WithInterceptors(NewClientInterceptor(client, WithTokenCache(cache))),
+	)
+
+	ctx := vortex.ContextWithUser(context.Background(), &vortex.User{ID: "user-123"})
+	for i := 0; i < 3; i++ {
+		if _, err := rpc.CallUnary(ctx, connect.NewRequest(wrapperspb.String(""))); err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+	}
+	if cache.sets != 1 {
+		t.Errorf("Expected 1 minted token, got %d", cache.sets)
+	}
+}
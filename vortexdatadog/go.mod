@@ -0,0 +1,19 @@
+module github.com/TeamVortexSoftware/vortex-go-sdk/vortexdatadog
+
+go 1.18
+
+require (
+	github.com/TeamVortexSoftware/vortex-go-sdk v1.2.0
+	gopkg.in/DataDog/dd-trace-go.v1 v1.13.1
+)
+
+require (
+	github.com/google/uuid v1.6.0 // indirect
+	github.com/opentracing/opentracing-go v1.2.0 // indirect
+	github.com/philhofer/fwd v1.0.0 // indirect
+	github.com/stretchr/testify v1.8.4 // indirect
+	github.com/tinylib/msgp v1.1.0 // indirect
+	golang.org/x/sys v0.9.0 // indirect
+)
+
+replace github.com/TeamVortexSoftware/vortex-go-sdk => ../
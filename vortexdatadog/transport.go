@@ -0,0 +1,125 @@
+// Package vortexdatadog traces Vortex API calls with Datadog APM.
+//
+// Wrap the HTTP client passed to the Vortex client and every API call is
+// recorded as a vortex.request span, a child of the span in the request's
+// context. Spans end when the response body is closed, which the Vortex
+// client does after reading it:
+//
+//	client := vortex.NewClientWithOptions(apiKey, "", vortexdatadog.WrapClient(nil))
+package vortexdatadog
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"gopkg.in/DataDog/dd-trace-go.v1/ddtrace"
+	"gopkg.in/DataDog/dd-trace-go.v1/ddtrace/ext"
+	"gopkg.in/DataDog/dd-trace-go.v1/ddtrace/tracer"
+)
+
+const (
+	// OperationName is the name of the spans emitted for Vortex calls
+	OperationName = "vortex.request"
+	// DefaultServiceName is the service spans are reported under
+	DefaultServiceName = "vortex"
+)
+
+// Transport is an http.RoundTripper emitting a span for each request
+type Transport struct {
+	// Base is the underlying transport; http.DefaultTransport is used when nil
+	Base http.RoundTripper
+
+	// ServiceName overrides DefaultServiceName
+	ServiceName string
+}
+
+// WrapClient returns a copy of client whose requests are traced; a nil client
+// is replaced with one using the SDK's default 30 second timeout
+func WrapClient(client *http.Client) *http.Client {
+	if client == nil {
+		client = &http.Client{Timeout: 30 * time.Second}
+	}
+
+	wrapped := *client
+	wrapped.Transport = &Transport{Base: client.Transport}
+	return &wrapped
+}
+
+// RoundTrip implements http.RoundTripper
+func (t *Transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	service := t.ServiceName
+	if service == "" {
+		service = DefaultServiceName
+	}
+
+	span, ctx := tracer.StartSpanFromContext(req.Context(), OperationName,
+		tracer.SpanType(ext.SpanTypeHTTP),
+		tracer.ServiceName(service),
+		tracer.ResourceName(req.Method+" "+resourcePath(req.URL.Path)),
+		tracer.Tag(ext.HTTPMethod, req.Method),
+		tracer.Tag(ext.HTTPURL, req.URL.Path),
+	)
+
+	resp, err := t.base().RoundTrip(req.WithContext(ctx))
+	if err != nil {
+		span.Finish(tracer.WithError(err))
+		return nil, err
+	}
+
+	span.SetTag(ext.HTTPCode, fmt.Sprint(resp.StatusCode))
+	if resp.StatusCode >= 400 {
+		span.SetTag(ext.Error, fmt.Errorf("Vortex API request failed: %s", resp.Status))
+	}
+
+	// Finish the span once the body has been read and closed rather than
+	// when the headers arrive
+	resp.Body = &spanBody{ReadCloser: resp.Body, span: span}
+	return resp, nil
+}
+
+// spanBody finishes a span when the response body is closed
+type spanBody struct {
+	io.ReadCloser
+	span ddtrace.Span
+	once sync.Once
+}
+
+// Close implements io.Closer
+func (b *spanBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.once.Do(func() { b.span.Finish() })
+	return err
+}
+
+func (t *Transport) base() http.RoundTripper {
+	if t.Base != nil {
+		return t.Base
+	}
+	return http.DefaultTransport
+}
+
+// resourcePath replaces IDs in an API path with placeholders so spans group
+// by endpoint, e.g. /api/v1/invitations/{id}/reinvite
+func resourcePath(path string) string {
+	segments := strings.Split(path, "/")
+	for i, segment := range segments {
+		if segment != "" && !fixedSegments[segment] {
+			segments[i] = "{id}"
+		}
+	}
+	return strings.Join(segments, "/")
+}
+
+var fixedSegments = map[string]bool{
+	"api":         true,
+	"v1":          true,
+	"invitations": true,
+	"accept":      true,
+	"by-group":    true,
+	"reinvite":    true,
+	"graphql":     true,
+}
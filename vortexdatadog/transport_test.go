@@ -0,0 +1,87 @@
+package vortexdatadog
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	vortex "github.com/TeamVortexSoftware/vortex-go-sdk"
+	"gopkg.in/DataDog/dd-trace-go.v1/ddtrace/ext"
+	"gopkg.in/DataDog/dd-trace-go.v1/ddtrace/mocktracer"
+)
+
+func TestTransport(t *testing.T) {
+	mt := mocktracer.Start()
+	defer mt.Stop()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "DELETE" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Write([]byte(`{"id":"inv-1"}`))
+	}))
+	defer server.Close()
+
+	client := vortex.NewClientWithOptions("test-api-key", server.URL, WrapClient(nil))
+	client.GetInvitation("inv-1")
+	client.RevokeInvitation("inv-2")
+
+	spans := mt.FinishedSpans()
+	if len(spans) != 2 {
+		t.Fatalf("Expected 2 spans, got %d", len(spans))
+	}
+
+	if spans[0].OperationName() != OperationName {
+		t.Errorf("Expected operation %s, got %s", OperationName, spans[0].OperationName())
+	}
+	if resource := spans[0].Tag(ext.ResourceName); resource != "GET /api/v1/invitations/{id}" {
+		t.Errorf("Expected resource 'GET /api/v1/invitations/{id}', got %v", resource)
+	}
+	if service := spans[0].Tag(ext.ServiceName); service != DefaultServiceName {
+		t.Errorf("Expected service %s, got %v", DefaultServiceName, service)
+	}
+	if code := spans[1].Tag(ext.HTTPCode); code != "404" {
+		t.Errorf("Expected status code 404, got %v", code)
+	}
+	if spans[1].Tag(ext.Error) == nil {
+		t.Error("Expected failed request to be marked as an error")
+	}
+}
+
+func TestResourcePath(t *testing.T) {
+	tests := map[string]string{
+		"/api/v1/invitations":                     "/api/v1/invitations",
+		"/api/v1/invitations/accept":              "/api/v1/invitations/accept",
+		"/api/v1/invitations/inv-1/reinvite":      "/api/v1/invitations/{id}/reinvite",
+		"/api/v1/invitations/by-group/team/t-123": "/api/v1/invitations/by-group/{id}/{id}",
+	}
+	for path, expected := range tests {
+		if got := resourcePath(path); got != expected {
+			t.Errorf("Expected %s for %s, got %s", expected, path, got)
+		}
+	}
+}
+
+func TestTransport_FinishesOnBodyClose(t *testing.T) {
+	mt := mocktracer.Start()
+	defer mt.Stop()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	resp, err := WrapClient(nil).Get(server.URL + "/api/v1/invitations/inv-1")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if n := len(mt.FinishedSpans()); n != 0 {
+		t.Errorf("Expected no finished spans before the body is closed, got %d", n)
+	}
+
+	resp.Body.Close()
+	if n := len(mt.FinishedSpans()); n != 1 {
+		t.Errorf("Expected 1 finished span after the body is closed, got %d", n)
+	}
+}
@@ -0,0 +1,52 @@
+// Package vortexecho serves the Vortex widget's token endpoint and Vortex
+// webhooks from Echo.
+//
+//	e := echo.New()
+//	e.Any("/vortex/token", vortexecho.TokenHandler(client, func(c echo.Context) (*vortex.User, error) {
+//	    userID, _ := c.Get("userID").(string)
+//	    if userID == "" {
+//	        return nil, errors.New("not signed in")
+//	    }
+//	    return &vortex.User{ID: userID}, nil
+//	}, vortex.AllowOrigins("https://app.example.com")))
+//	e.POST("/webhooks/vortex", vortexecho.WebhookHandler(&vortexwebhooks.Handler{
+//	    Secret: os.Getenv("VORTEX_WEBHOOK_SECRET"),
+//	    Handle: handleEvent,
+//	}))
+package vortexecho
+
+import (
+	"context"
+	"net/http"
+
+	vortex "github.com/TeamVortexSoftware/vortex-go-sdk"
+	"github.com/TeamVortexSoftware/vortex-go-sdk/vortexwebhooks"
+	"github.com/labstack/echo/v4"
+)
+
+type echoContextKey struct{}
+
+// UserFromContextFunc returns the signed-in user making the request, e.g.
+// from values set by the application's auth middleware; an error or nil user
+// rejects the request
+type UserFromContextFunc func(c echo.Context) (*vortex.User, error)
+
+// TokenHandler issues JWTs for the widget like vortex.TokenHandler; register
+// it with Any so CORS preflight requests reach it
+func TokenHandler(client *vortex.Client, userFromContext UserFromContextFunc, opts ...vortex.TokenHandlerOption) echo.HandlerFunc {
+	handler := vortex.TokenHandler(client, func(r *http.Request) (*vortex.User, error) {
+		return userFromContext(r.Context().Value(echoContextKey{}).(echo.Context))
+	}, opts...)
+
+	return func(c echo.Context) error {
+		req := c.Request()
+		ctx := context.WithValue(req.Context(), echoContextKey{}, c)
+		handler.ServeHTTP(c.Response(), req.WithContext(ctx))
+		return nil
+	}
+}
+
+// WebhookHandler receives Vortex webhooks with handler
+func WebhookHandler(handler *vortexwebhooks.Handler) echo.HandlerFunc {
+	return echo.WrapHandler(handler)
+}
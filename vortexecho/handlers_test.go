@@ -0,0 +1,93 @@
+package vortexecho
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	vortex "github.com/TeamVortexSoftware/vortex-go-sdk"
+	"github.com/TeamVortexSoftware/vortex-go-sdk/vortexwebhooks"
+	"github.com/labstack/echo/v4"
+)
+
+func newTestRouter(client *vortex.Client, handled *[]string) *echo.Echo {
+	r := echo.New()
+	r.Use(func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			if c.Request().Header.Get("Cookie") == "session=ok" {
+				c.Set("userID", "user-123")
+			}
+			return next(c)
+		}
+	})
+	r.Any("/vortex/token", TokenHandler(client, func(c echo.Context) (*vortex.User, error) {
+		userID, _ := c.Get("userID").(string)
+		if userID == "" {
+			return nil, errors.New("not signed in")
+		}
+		return &vortex.User{ID: userID, Email: "test@example.com"}, nil
+	}))
+	r.POST("/webhooks/vortex", WebhookHandler(&vortexwebhooks.Handler{
+		Secret: "whsec_test",
+		Handle: func(ctx context.Context, event vortexwebhooks.Event) error {
+			*handled = append(*handled, event.ID)
+			return nil
+		},
+	}))
+	return r
+}
+
+func TestTokenHandler(t *testing.T) {
+	client := vortex.NewClient("VRTX.EjRWeBI0EjQSNBI0VniQEg.test-key")
+	r := newTestRouter(client, nil)
+
+	req := httptest.NewRequest("GET", "/vortex/token", nil)
+	req.Header.Set("Cookie", "session=ok")
+	rec := httptest.NewRecorder()
+	r.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("Expected status 200, got %d", rec.Code)
+	}
+	var body struct {
+		Token string `json:"token"`
+	}
+	json.Unmarshal(rec.Body.Bytes(), &body)
+	claims, err := client.VerifyJWT(body.Token)
+	if err != nil {
+		t.Fatalf("Expected a valid token, got %v", err)
+	}
+	if claims.UserID != "user-123" {
+		t.Errorf("Expected user-123, got %s", claims.UserID)
+	}
+
+	req = httptest.NewRequest("GET", "/vortex/token", nil)
+	rec = httptest.NewRecorder()
+	r.ServeHTTP(rec, req)
+	if rec.Code != http.StatusUnauthorized {
+		t.Errorf("Expected status 401, got %d", rec.Code)
+	}
+}
+
+func TestWebhookHandler(t *testing.T) {
+	var handled []string
+	r := newTestRouter(vortex.NewClient("VRTX.EjRWeBI0EjQSNBI0VniQEg.test-key"), &handled)
+
+	payload := `{"id":"evt-1","type":"invitation.accepted","data":{}}`
+	req := httptest.NewRequest("POST", "/webhooks/vortex", strings.NewReader(payload))
+	req.Header.Set(vortexwebhooks.SignatureHeader, vortexwebhooks.Sign([]byte(payload), "whsec_test", time.Now()))
+	rec := httptest.NewRecorder()
+	r.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Errorf("Expected status 200, got %d", rec.Code)
+	}
+	if len(handled) != 1 || handled[0] != "evt-1" {
+		t.Errorf("Expected evt-1 to be handled, got %v", handled)
+	}
+}
@@ -0,0 +1,115 @@
+// Package vortexfiber provides Fiber middleware that verifies Vortex JWTs and
+// helpers to read the verified claims in handlers.
+//
+//	app := fiber.New()
+//	app.Use(vortexfiber.New(client))
+//	app.Get("/me", func(c *fiber.Ctx) error {
+//	    return c.SendString(vortexfiber.UserID(c))
+//	})
+package vortexfiber
+
+import (
+	"strings"
+
+	vortex "github.com/TeamVortexSoftware/vortex-go-sdk"
+	"github.com/gofiber/fiber/v2"
+)
+
+// claimsKey is the fiber.Ctx Locals key holding the verified claims
+const claimsKey = "vortexClaims"
+
+// Config customizes the middleware
+type Config struct {
+	// TokenLookup extracts the JWT from the request. Defaults to the bearer
+	// token in the Authorization header.
+	TokenLookup func(c *fiber.Ctx) string
+
+	// ErrorHandler is called when the token is missing or invalid. Defaults to
+	// a 401 JSON response.
+	ErrorHandler func(c *fiber.Ctx, err error) error
+
+	// Optional makes requests without a token pass through unauthenticated;
+	// invalid tokens are still rejected.
+	Optional bool
+}
+
+// New creates middleware that verifies the request's JWT with the client's
+// API key and stores its claims for the handlers
+func New(client *vortex.Client, config ...Config) fiber.Handler {
+	var cfg Config
+	if len(config) > 0 {
+		cfg = config[0]
+	}
+	if cfg.TokenLookup == nil {
+		cfg.TokenLookup = bearerToken
+	}
+	if cfg.ErrorHandler == nil {
+		cfg.ErrorHandler = unauthorized
+	}
+
+	return func(c *fiber.Ctx) error {
+		token := cfg.TokenLookup(c)
+		if token == "" {
+			if cfg.Optional {
+				return c.Next()
+			}
+			return cfg.ErrorHandler(c, fiber.NewError(fiber.StatusUnauthorized, "missing token"))
+		}
+
+		claims, err := client.VerifyJWT(token)
+		if err != nil {
+			return cfg.ErrorHandler(c, err)
+		}
+
+		c.Locals(claimsKey, claims)
+		return c.Next()
+	}
+}
+
+// Claims returns the verified claims for the request
+func Claims(c *fiber.Ctx) (*vortex.JWTClaims, bool) {
+	claims, ok := c.Locals(claimsKey).(*vortex.JWTClaims)
+	return claims, ok
+}
+
+// UserID returns the verified user ID, or an empty string if there is none
+func UserID(c *fiber.Ctx) string {
+	if claims, ok := Claims(c); ok {
+		return claims.UserID
+	}
+	return ""
+}
+
+// UserEmail returns the verified user email, or an empty string if there is none
+func UserEmail(c *fiber.Ctx) string {
+	if claims, ok := Claims(c); ok {
+		return claims.UserEmail
+	}
+	return ""
+}
+
+// HasAdminScope reports whether the verified user has the given admin scope
+func HasAdminScope(c *fiber.Ctx, scope string) bool {
+	claims, ok := Claims(c)
+	if !ok {
+		return false
+	}
+	for _, s := range claims.AdminScopes {
+		if s == scope {
+			return true
+		}
+	}
+	return false
+}
+
+func bearerToken(c *fiber.Ctx) string {
+	header := c.Get(fiber.HeaderAuthorization)
+	if len(header) > 7 && strings.EqualFold(header[:7], "Bearer ") {
+		return strings.TrimSpace(header[7:])
+	}
+	return ""
+}
+
+func unauthorized(c *fiber.Ctx, err error) error {
+	return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": "unauthorized"})
+}
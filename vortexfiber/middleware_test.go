@@ -0,0 +1,79 @@
+package vortexfiber
+
+import (
+	"io"
+	"net/http/httptest"
+	"testing"
+
+	vortex "github.com/TeamVortexSoftware/vortex-go-sdk"
+	"github.com/gofiber/fiber/v2"
+)
+
+func newTestApp(client *vortex.Client, config ...Config) *fiber.App {
+	app := fiber.New()
+	app.Use(New(client, config...))
+	app.Get("/me", func(c *fiber.Ctx) error {
+		if HasAdminScope(c, "autojoin") {
+			return c.SendString(UserID(c) + " (admin)")
+		}
+		return c.SendString(UserID(c))
+	})
+	return app
+}
+
+func TestMiddleware(t *testing.T) {
+	client := vortex.NewClient("VRTX.EjRWeBI0EjQSNBI0VniQEg.test-key")
+	jwt, err := client.GenerateJWT(&vortex.User{
+		ID:          "user-123",
+		Email:       "test@example.com",
+		AdminScopes: []string{"autojoin"},
+	}, nil)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	req := httptest.NewRequest("GET", "/me", nil)
+	req.Header.Set("Authorization", "Bearer "+jwt)
+	resp, err := newTestApp(client).Test(req)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	body, _ := io.ReadAll(resp.Body)
+	if resp.StatusCode != fiber.StatusOK {
+		t.Fatalf("Expected status 200, got %d", resp.StatusCode)
+	}
+	if string(body) != "user-123 (admin)" {
+		t.Errorf("Expected body 'user-123 (admin)', got %q", body)
+	}
+}
+
+func TestMiddleware_Unauthorized(t *testing.T) {
+	client := vortex.NewClient("VRTX.EjRWeBI0EjQSNBI0VniQEg.test-key")
+
+	for _, header := range []string{"", "Bearer not-a-jwt"} {
+		req := httptest.NewRequest("GET", "/me", nil)
+		if header != "" {
+			req.Header.Set("Authorization", header)
+		}
+		resp, err := newTestApp(client).Test(req)
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if resp.StatusCode != fiber.StatusUnauthorized {
+			t.Errorf("Expected status 401 for %q, got %d", header, resp.StatusCode)
+		}
+	}
+}
+
+func TestMiddleware_Optional(t *testing.T) {
+	client := vortex.NewClient("VRTX.EjRWeBI0EjQSNBI0VniQEg.test-key")
+
+	resp, err := newTestApp(client, Config{Optional: true}).Test(httptest.NewRequest("GET", "/me", nil))
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if resp.StatusCode != fiber.StatusOK {
+		t.Errorf("Expected status 200, got %d", resp.StatusCode)
+	}
+}
@@ -0,0 +1,50 @@
+// Package vortexgin serves the Vortex widget's token endpoint and Vortex
+// webhooks from Gin.
+//
+//	r := gin.Default()
+//	r.Any("/vortex/token", vortexgin.TokenHandler(client, func(c *gin.Context) (*vortex.User, error) {
+//	    userID := c.GetString("userID")
+//	    if userID == "" {
+//	        return nil, errors.New("not signed in")
+//	    }
+//	    return &vortex.User{ID: userID, Email: c.GetString("email")}, nil
+//	}, vortex.AllowOrigins("https://app.example.com")))
+//	r.POST("/webhooks/vortex", vortexgin.WebhookHandler(&vortexwebhooks.Handler{
+//	    Secret: os.Getenv("VORTEX_WEBHOOK_SECRET"),
+//	    Handle: handleEvent,
+//	}))
+package vortexgin
+
+import (
+	"context"
+	"net/http"
+
+	vortex "github.com/TeamVortexSoftware/vortex-go-sdk"
+	"github.com/TeamVortexSoftware/vortex-go-sdk/vortexwebhooks"
+	"github.com/gin-gonic/gin"
+)
+
+type ginContextKey struct{}
+
+// UserFromContextFunc returns the signed-in user making the request, e.g.
+// from values set by the application's auth middleware; an error or nil user
+// rejects the request
+type UserFromContextFunc func(c *gin.Context) (*vortex.User, error)
+
+// TokenHandler issues JWTs for the widget like vortex.TokenHandler; register
+// it with Any so CORS preflight requests reach it
+func TokenHandler(client *vortex.Client, userFromContext UserFromContextFunc, opts ...vortex.TokenHandlerOption) gin.HandlerFunc {
+	handler := vortex.TokenHandler(client, func(r *http.Request) (*vortex.User, error) {
+		return userFromContext(r.Context().Value(ginContextKey{}).(*gin.Context))
+	}, opts...)
+
+	return func(c *gin.Context) {
+		ctx := context.WithValue(c.Request.Context(), ginContextKey{}, c)
+		handler.ServeHTTP(c.Writer, c.Request.WithContext(ctx))
+	}
+}
+
+// WebhookHandler receives Vortex webhooks with handler
+func WebhookHandler(handler *vortexwebhooks.Handler) gin.HandlerFunc {
+	return gin.WrapH(handler)
+}
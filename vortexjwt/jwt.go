@@ -0,0 +1,176 @@
+// Package vortexjwt is the dependency-light core used to sign and verify
+// Vortex JWTs.
+//
+// It only needs the standard library's crypto and encoding packages (plus
+// google/uuid outside TinyGo), so tokens can be minted in TinyGo and other
+// constrained environments that cannot build the full HTTP client. Most
+// applications should use vortex.Client.GenerateJWT instead.
+package vortexjwt
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Header is the JWT header written for Vortex tokens
+type Header struct {
+	IAT int64  `json:"iat"`
+	Alg string `json:"alg"`
+	Typ string `json:"typ"`
+	Kid string `json:"kid"`
+}
+
+// APIKeyError reports an API key that cannot be parsed
+type APIKeyError struct {
+	msg string
+	err error
+}
+
+// Error implements error
+func (e *APIKeyError) Error() string {
+	if e.err != nil {
+		return e.msg + ": " + e.err.Error()
+	}
+	return e.msg
+}
+
+// Unwrap returns the underlying decoding error, if any
+func (e *APIKeyError) Unwrap() error {
+	return e.err
+}
+
+// Key is the signing key derived from a Vortex API key
+type Key struct {
+	// ID is the API key's UUID, used as the JWT kid
+	ID string
+
+	secret []byte
+}
+
+// ParseAPIKey derives the signing key from an API key of the form
+// VRTX.<base64url UUID>.<secret>
+func ParseAPIKey(apiKey string) (*Key, error) {
+	// Parse API key: format is VRTX.base64encodedId.key
+	parts := strings.Split(apiKey, ".")
+	if len(parts) != 3 {
+		return nil, &APIKeyError{msg: "invalid API key format"}
+	}
+
+	prefix := parts[0]
+	encodedID := parts[1]
+	key := parts[2]
+
+	if prefix != "VRTX" {
+		return nil, &APIKeyError{msg: "invalid API key prefix"}
+	}
+
+	// Decode the UUID from base64url
+	uuidBytes, err := base64.RawURLEncoding.DecodeString(encodedID)
+	if err != nil {
+		return nil, &APIKeyError{msg: "failed to decode API key ID", err: err}
+	}
+
+	// Convert bytes to UUID string
+	id, err := uuidString(uuidBytes)
+	if err != nil {
+		return nil, &APIKeyError{msg: "failed to parse UUID from API key", err: err}
+	}
+
+	// Derive signing key from API key + ID
+	signingKeyHmac := hmac.New(sha256.New, []byte(key))
+	signingKeyHmac.Write([]byte(id))
+
+	return &Key{ID: id, secret: signingKeyHmac.Sum(nil)}, nil
+}
+
+// Sign creates an HS256 JWT for the payload with the given issued-at time
+func (k *Key) Sign(payload interface{}, issuedAt time.Time) (string, error) {
+	payloadJSON, err := json.Marshal(payload)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal JWT payload: %w", err)
+	}
+
+	return k.SignJSON(payloadJSON, issuedAt)
+}
+
+// SignJSON is like Sign but takes the payload already encoded, for callers
+// that need exact control over its bytes
+func (k *Key) SignJSON(payloadJSON []byte, issuedAt time.Time) (string, error) {
+	header := Header{
+		IAT: issuedAt.Unix(),
+		Alg: "HS256",
+		Typ: "JWT",
+		Kid: k.ID,
+	}
+
+	headerJSON, err := json.Marshal(header)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal JWT header: %w", err)
+	}
+
+	// Base64URL encode header and payload
+	headerB64 := base64.RawURLEncoding.EncodeToString(headerJSON)
+	payloadB64 := base64.RawURLEncoding.EncodeToString(payloadJSON)
+
+	// Sign
+	toSign := headerB64 + "." + payloadB64
+	signature := base64.RawURLEncoding.EncodeToString(k.signature(toSign))
+
+	return toSign + "." + signature, nil
+}
+
+// Verify checks that the token was signed with this key and returns its
+// decoded payload JSON
+//
+// Verify does not interpret any claims; callers are responsible for checking
+// expiry.
+func (k *Key) Verify(token string) ([]byte, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return nil, fmt.Errorf("invalid JWT format")
+	}
+
+	headerJSON, err := base64.RawURLEncoding.DecodeString(parts[0])
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode JWT header: %w", err)
+	}
+
+	var header Header
+	if err := json.Unmarshal(headerJSON, &header); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal JWT header: %w", err)
+	}
+
+	if header.Alg != "HS256" {
+		return nil, fmt.Errorf("unsupported JWT algorithm: %s", header.Alg)
+	}
+	if header.Kid != k.ID {
+		return nil, fmt.Errorf("JWT was not issued for this API key")
+	}
+
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode JWT signature: %w", err)
+	}
+
+	if !hmac.Equal(signature, k.signature(parts[0]+"."+parts[1])) {
+		return nil, fmt.Errorf("invalid JWT signature")
+	}
+
+	payloadJSON, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode JWT payload: %w", err)
+	}
+
+	return payloadJSON, nil
+}
+
+func (k *Key) signature(toSign string) []byte {
+	signatureHmac := hmac.New(sha256.New, k.secret)
+	signatureHmac.Write([]byte(toSign))
+	return signatureHmac.Sum(nil)
+}
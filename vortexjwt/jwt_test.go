@@ -0,0 +1,77 @@
+package vortexjwt
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const testAPIKey = "VRTX.EjRWeBI0EjQSNBI0VniQEg.test-key"
+
+func TestParseAPIKey(t *testing.T) {
+	key, err := ParseAPIKey(testAPIKey)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if key.ID != "12345678-1234-1234-1234-123456789012" {
+		t.Errorf("Expected key ID to be '12345678-1234-1234-1234-123456789012', got %s", key.ID)
+	}
+
+	for _, apiKey := range []string{"invalid-key", "WRONG.EjRWeBI0EjQSNBI0VniQEg.test-key", "VRTX.invalid-base64.test-key"} {
+		_, err := ParseAPIKey(apiKey)
+		var keyErr *APIKeyError
+		if !errors.As(err, &keyErr) {
+			t.Errorf("Expected APIKeyError for API key %q, got %v", apiKey, err)
+		}
+	}
+}
+
+func TestSignAndVerify(t *testing.T) {
+	key, err := ParseAPIKey(testAPIKey)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	token, err := key.Sign(map[string]interface{}{"userId": "user-123"}, time.Unix(1700000000, 0))
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	payloadJSON, err := key.Verify(token)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	var payload map[string]interface{}
+	if err := json.Unmarshal(payloadJSON, &payload); err != nil {
+		t.Fatalf("Failed to unmarshal payload: %v", err)
+	}
+	if payload["userId"] != "user-123" {
+		t.Errorf("Expected userId to be 'user-123', got %v", payload["userId"])
+	}
+
+	other, _ := ParseAPIKey("VRTX.EjRWeBI0EjQSNBI0VniQEg.other-key")
+	if _, err := other.Verify(token); err == nil {
+		t.Error("Expected error verifying with a different key")
+	}
+}
+
+func TestFormatUUIDMatchesGoogleUUID(t *testing.T) {
+	for i := 0; i < 10; i++ {
+		id := uuid.New()
+		got, err := formatUUID(id[:])
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if got != id.String() {
+			t.Errorf("Expected %s, got %s", id.String(), got)
+		}
+	}
+
+	if _, err := formatUUID([]byte{1, 2, 3}); err == nil {
+		t.Error("Expected error for short input")
+	}
+}
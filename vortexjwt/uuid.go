@@ -0,0 +1,25 @@
+package vortexjwt
+
+import (
+	"encoding/hex"
+	"fmt"
+)
+
+// formatUUID renders 16 bytes in the canonical 8-4-4-4-12 UUID form
+func formatUUID(b []byte) (string, error) {
+	if len(b) != 16 {
+		return "", fmt.Errorf("invalid UUID (got %d bytes)", len(b))
+	}
+
+	var buf [36]byte
+	hex.Encode(buf[0:8], b[0:4])
+	buf[8] = '-'
+	hex.Encode(buf[9:13], b[4:6])
+	buf[13] = '-'
+	hex.Encode(buf[14:18], b[6:8])
+	buf[18] = '-'
+	hex.Encode(buf[19:23], b[8:10])
+	buf[23] = '-'
+	hex.Encode(buf[24:], b[10:])
+	return string(buf[:]), nil
+}
@@ -0,0 +1,14 @@
+//go:build !tinygo
+// +build !tinygo
+
+package vortexjwt
+
+import "github.com/google/uuid"
+
+func uuidString(b []byte) (string, error) {
+	id, err := uuid.FromBytes(b)
+	if err != nil {
+		return "", err
+	}
+	return id.String(), nil
+}
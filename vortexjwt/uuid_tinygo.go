@@ -0,0 +1,9 @@
+//go:build tinygo
+// +build tinygo
+
+package vortexjwt
+
+// TinyGo builds avoid the google/uuid dependency
+func uuidString(b []byte) (string, error) {
+	return formatUUID(b)
+}
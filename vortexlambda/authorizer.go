@@ -0,0 +1,151 @@
+// Package vortexlambda provides an AWS API Gateway Lambda authorizer that
+// verifies Vortex JWTs.
+//
+// The request and response types mirror the API Gateway custom authorizer
+// payloads, so the handler can be passed straight to lambda.Start without
+// pulling the AWS SDK into the Vortex SDK:
+//
+//	client := vortex.NewClient(os.Getenv("VORTEX_API_KEY"))
+//	lambda.Start(vortexlambda.NewAuthorizer(client).Handle)
+package vortexlambda
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	vortex "github.com/TeamVortexSoftware/vortex-go-sdk"
+)
+
+// ErrUnauthorized is returned for missing or invalid tokens; API Gateway
+// maps this exact message to a 401 response
+var ErrUnauthorized = errors.New("Unauthorized")
+
+// Request is an API Gateway custom authorizer request of type TOKEN or REQUEST
+type Request struct {
+	Type               string            `json:"type"`
+	AuthorizationToken string            `json:"authorizationToken,omitempty"`
+	MethodArn          string            `json:"methodArn"`
+	Headers            map[string]string `json:"headers,omitempty"`
+}
+
+// Response is an API Gateway custom authorizer response
+type Response struct {
+	PrincipalID    string                 `json:"principalId"`
+	PolicyDocument PolicyDocument         `json:"policyDocument"`
+	Context        map[string]interface{} `json:"context,omitempty"`
+}
+
+// PolicyDocument is the IAM policy returned to API Gateway
+type PolicyDocument struct {
+	Version   string            `json:"Version"`
+	Statement []PolicyStatement `json:"Statement"`
+}
+
+// PolicyStatement is a single IAM policy statement
+type PolicyStatement struct {
+	Action   string   `json:"Action"`
+	Effect   string   `json:"Effect"`
+	Resource []string `json:"Resource"`
+}
+
+// Authorizer verifies Vortex JWTs for API Gateway
+type Authorizer struct {
+	client *vortex.Client
+
+	// Resource returns the resources the caller is allowed to invoke. By
+	// default only the requested method ARN is allowed.
+	Resource func(methodArn string) []string
+
+	// Deny returns a Deny policy for invalid tokens, which API Gateway
+	// answers with 403. By default invalid tokens return ErrUnauthorized
+	// and get a 401. Missing tokens always return ErrUnauthorized.
+	Deny bool
+}
+
+// NewAuthorizer creates an authorizer that verifies tokens with the client's API key
+func NewAuthorizer(client *vortex.Client) *Authorizer {
+	return &Authorizer{client: client}
+}
+
+// Handle verifies the bearer token in the request and returns an Allow policy
+// with the user's claims in the authorizer context
+//
+// Rejected requests return ErrUnauthorized, which API Gateway maps to a 401,
+// unless Deny is set.
+//
+// The context contains userId, userEmail and adminScopes (comma separated),
+// available to integrations as $context.authorizer.<key>.
+func (a *Authorizer) Handle(ctx context.Context, req Request) (Response, error) {
+	token := bearerToken(req)
+	if token == "" {
+		return Response{}, ErrUnauthorized
+	}
+
+	claims, err := a.client.VerifyJWT(token)
+	if err != nil {
+		if a.Deny {
+			return Response{
+				PrincipalID:    "anonymous",
+				PolicyDocument: policy("Deny", []string{req.MethodArn}),
+			}, nil
+		}
+		return Response{}, ErrUnauthorized
+	}
+
+	resources := []string{req.MethodArn}
+	if a.Resource != nil {
+		resources = a.Resource(req.MethodArn)
+	}
+
+	return Response{
+		PrincipalID:    claims.UserID,
+		PolicyDocument: policy("Allow", resources),
+		Context: map[string]interface{}{
+			"userId":      claims.UserID,
+			"userEmail":   claims.UserEmail,
+			"adminScopes": strings.Join(claims.AdminScopes, ","),
+		},
+	}, nil
+}
+
+func policy(effect string, resources []string) PolicyDocument {
+	return PolicyDocument{
+		Version: "2012-10-17",
+		Statement: []PolicyStatement{
+			{
+				Action:   "execute-api:Invoke",
+				Effect:   effect,
+				Resource: resources,
+			},
+		},
+	}
+}
+
+// AllowAPI grants access to every method and path of the API stage in the
+// method ARN, which lets API Gateway cache one policy per token
+func AllowAPI(methodArn string) []string {
+	// arn:aws:execute-api:region:account:apiId/stage/METHOD/path
+	arnParts := strings.SplitN(methodArn, "/", 3)
+	if len(arnParts) < 2 {
+		return []string{methodArn}
+	}
+	return []string{arnParts[0] + "/" + arnParts[1] + "/*"}
+}
+
+func bearerToken(req Request) string {
+	header := req.AuthorizationToken
+	if header == "" {
+		for key, value := range req.Headers {
+			if strings.EqualFold(key, "Authorization") {
+				header = value
+				break
+			}
+		}
+	}
+
+	if len(header) > 7 && strings.EqualFold(header[:7], "Bearer ") {
+		return strings.TrimSpace(header[7:])
+	}
+	return ""
+}
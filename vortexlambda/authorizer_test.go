@@ -0,0 +1,120 @@
+package vortexlambda
+
+import (
+	"context"
+	"testing"
+
+	vortex "github.com/TeamVortexSoftware/vortex-go-sdk"
+)
+
+const testAPIKey = "VRTX.EjRWeBI0EjQSNBI0VniQEg.test-key"
+
+func TestAuthorizer_Allow(t *testing.T) {
+	client := vortex.NewClient(testAPIKey)
+	jwt, err := client.GenerateJWT(&vortex.User{
+		ID:          "user-123",
+		Email:       "test@example.com",
+		AdminScopes: []string{"autojoin"},
+	}, nil)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	methodArn := "arn:aws:execute-api:us-east-1:123456789012:abc123/prod/GET/invitations"
+	resp, err := NewAuthorizer(client).Handle(context.Background(), Request{
+		Type:               "TOKEN",
+		AuthorizationToken: "Bearer " + jwt,
+		MethodArn:          methodArn,
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if resp.PrincipalID != "user-123" {
+		t.Errorf("Expected principalId to be 'user-123', got %s", resp.PrincipalID)
+	}
+	statement := resp.PolicyDocument.Statement[0]
+	if statement.Effect != "Allow" || statement.Resource[0] != methodArn {
+		t.Errorf("Unexpected policy statement: %+v", statement)
+	}
+	if resp.Context["adminScopes"] != "autojoin" {
+		t.Errorf("Expected adminScopes context to be 'autojoin', got %v", resp.Context["adminScopes"])
+	}
+}
+
+func TestAuthorizer_RequestHeaders(t *testing.T) {
+	client := vortex.NewClient(testAPIKey)
+	jwt, err := client.GenerateJWT(&vortex.User{ID: "user-123", Email: "test@example.com"}, nil)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	authorizer := NewAuthorizer(client)
+	authorizer.Resource = AllowAPI
+
+	resp, err := authorizer.Handle(context.Background(), Request{
+		Type:      "REQUEST",
+		MethodArn: "arn:aws:execute-api:us-east-1:123456789012:abc123/prod/GET/invitations",
+		Headers:   map[string]string{"authorization": "Bearer " + jwt},
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	expected := "arn:aws:execute-api:us-east-1:123456789012:abc123/prod/*"
+	if resp.PolicyDocument.Statement[0].Resource[0] != expected {
+		t.Errorf("Expected resource %s, got %s", expected, resp.PolicyDocument.Statement[0].Resource[0])
+	}
+}
+
+func TestAuthorizer_Unauthorized(t *testing.T) {
+	client := vortex.NewClient(testAPIKey)
+	other := vortex.NewClient("VRTX.EjRWeBI0EjQSNBI0VniQEg.other-key")
+	jwt, err := other.GenerateJWT(&vortex.User{ID: "user-123", Email: "test@example.com"}, nil)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	tests := []struct {
+		name  string
+		token string
+	}{
+		{"missing token", ""},
+		{"not a bearer token", jwt},
+		{"wrong signing key", "Bearer " + jwt},
+		{"malformed token", "Bearer not-a-jwt"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewAuthorizer(client).Handle(context.Background(), Request{
+				Type:               "TOKEN",
+				AuthorizationToken: tt.token,
+			})
+			if err != ErrUnauthorized {
+				t.Errorf("Expected ErrUnauthorized, got %v", err)
+			}
+		})
+	}
+}
+
+func TestAuthorizer_Deny(t *testing.T) {
+	authorizer := NewAuthorizer(vortex.NewClient(testAPIKey))
+	authorizer.Deny = true
+
+	resp, err := authorizer.Handle(context.Background(), Request{
+		Type:               "TOKEN",
+		AuthorizationToken: "Bearer not-a-jwt",
+		MethodArn:          "arn:aws:execute-api:us-east-1:123:api/prod/GET/items",
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if effect := resp.PolicyDocument.Statement[0].Effect; effect != "Deny" {
+		t.Errorf("Expected Deny policy, got %s", effect)
+	}
+
+	if _, err := authorizer.Handle(context.Background(), Request{Type: "TOKEN"}); err != ErrUnauthorized {
+		t.Errorf("Expected ErrUnauthorized for missing token, got %v", err)
+	}
+}
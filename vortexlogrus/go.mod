@@ -0,0 +1,16 @@
+module github.com/TeamVortexSoftware/vortex-go-sdk/vortexlogrus
+
+go 1.18
+
+require (
+	github.com/TeamVortexSoftware/vortex-go-sdk v1.2.0
+	github.com/sirupsen/logrus v1.9.3
+)
+
+require (
+	github.com/google/uuid v1.6.0 // indirect
+	github.com/stretchr/testify v1.8.4 // indirect
+	golang.org/x/sys v0.9.0 // indirect
+)
+
+replace github.com/TeamVortexSoftware/vortex-go-sdk => ../
@@ -0,0 +1,63 @@
+// Package vortexlogrus adapts a logrus logger to vortex.Logger.
+//
+//	client := vortex.NewClient(apiKey, vortex.WithLogger(vortexlogrus.New(logrus.StandardLogger())))
+package vortexlogrus
+
+import (
+	"fmt"
+
+	vortex "github.com/TeamVortexSoftware/vortex-go-sdk"
+	"github.com/sirupsen/logrus"
+)
+
+// Logger is a vortex.Logger writing to a logrus logger
+type Logger struct {
+	logger logrus.FieldLogger
+}
+
+var _ vortex.Logger = (*Logger)(nil)
+
+// New creates a vortex.Logger writing to logger, which may be a *logrus.Logger
+// or a *logrus.Entry carrying fields of its own
+func New(logger logrus.FieldLogger) *Logger {
+	return &Logger{logger: logger}
+}
+
+// Debug implements vortex.Logger
+func (l *Logger) Debug(msg string, keysAndValues ...interface{}) {
+	l.logger.WithFields(fields(keysAndValues)).Debug(msg)
+}
+
+// Info implements vortex.Logger
+func (l *Logger) Info(msg string, keysAndValues ...interface{}) {
+	l.logger.WithFields(fields(keysAndValues)).Info(msg)
+}
+
+// Warn implements vortex.Logger
+func (l *Logger) Warn(msg string, keysAndValues ...interface{}) {
+	l.logger.WithFields(fields(keysAndValues)).Warn(msg)
+}
+
+// Error implements vortex.Logger
+func (l *Logger) Error(msg string, keysAndValues ...interface{}) {
+	l.logger.WithFields(fields(keysAndValues)).Error(msg)
+}
+
+// fields converts alternating keys and values to logrus fields; errors are
+// stored under logrus.ErrorKey and a trailing key without a value is kept as
+// "!BADKEY"
+func fields(keysAndValues []interface{}) logrus.Fields {
+	f := make(logrus.Fields, len(keysAndValues)/2)
+	for i := 0; i < len(keysAndValues); i += 2 {
+		if i+1 == len(keysAndValues) {
+			f["!BADKEY"] = keysAndValues[i]
+			break
+		}
+		key := fmt.Sprint(keysAndValues[i])
+		if key == "error" {
+			key = logrus.ErrorKey
+		}
+		f[key] = keysAndValues[i+1]
+	}
+	return f
+}
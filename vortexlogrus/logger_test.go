@@ -0,0 +1,42 @@
+package vortexlogrus
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"github.com/sirupsen/logrus/hooks/test"
+)
+
+func TestLogger(t *testing.T) {
+	base, hook := test.NewNullLogger()
+	base.SetLevel(logrus.DebugLevel)
+	logger := New(base)
+
+	logger.Debug("vortex request", "method", "GET", "path", "/api/v1/invitations")
+	logger.Error("vortex request failed", "method", "DELETE", "error", errors.New("boom"))
+
+	entries := hook.AllEntries()
+	if len(entries) != 2 {
+		t.Fatalf("Expected 2 log entries, got %d", len(entries))
+	}
+	if entries[0].Level != logrus.DebugLevel || entries[0].Message != "vortex request" {
+		t.Errorf("Expected debug 'vortex request', got %s %q", entries[0].Level, entries[0].Message)
+	}
+	if path := entries[0].Data["path"]; path != "/api/v1/invitations" {
+		t.Errorf("Expected path field '/api/v1/invitations', got %v", path)
+	}
+	if entries[1].Level != logrus.ErrorLevel {
+		t.Errorf("Expected error level, got %s", entries[1].Level)
+	}
+	if _, ok := entries[1].Data[logrus.ErrorKey].(error); !ok {
+		t.Errorf("Expected error under %q, got %v", logrus.ErrorKey, entries[1].Data)
+	}
+}
+
+func TestFields_OddArguments(t *testing.T) {
+	f := fields([]interface{}{"method", "GET", "dangling"})
+	if f["method"] != "GET" || f["!BADKEY"] != "dangling" {
+		t.Errorf("Expected method and !BADKEY fields, got %v", f)
+	}
+}
@@ -0,0 +1,12 @@
+module github.com/TeamVortexSoftware/vortex-go-sdk/vortexoauth2
+
+go 1.18
+
+require (
+	github.com/TeamVortexSoftware/vortex-go-sdk v1.2.0
+	golang.org/x/oauth2 v0.26.0
+)
+
+require github.com/google/uuid v1.6.0 // indirect
+
+replace github.com/TeamVortexSoftware/vortex-go-sdk => ../
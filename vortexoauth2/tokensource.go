@@ -0,0 +1,47 @@
+// Package vortexoauth2 exposes Vortex JWTs as an oauth2.TokenSource so they
+// can be used by any library that accepts one, such as oauth2.NewClient or
+// gRPC per-RPC credentials.
+//
+//	ts := vortexoauth2.TokenSource(client, user, nil)
+//	httpClient := oauth2.NewClient(ctx, ts)
+package vortexoauth2
+
+import (
+	"time"
+
+	vortex "github.com/TeamVortexSoftware/vortex-go-sdk"
+	"golang.org/x/oauth2"
+)
+
+// TokenSource returns a token source minting JWTs for the user
+//
+// Tokens are reused until shortly before they expire. The extra properties
+// are included in every JWT, as with Client.GenerateJWT.
+func TokenSource(client *vortex.Client, user *vortex.User, extra map[string]interface{}) oauth2.TokenSource {
+	return oauth2.ReuseTokenSource(nil, &tokenSource{client: client, user: user, extra: extra})
+}
+
+type tokenSource struct {
+	client *vortex.Client
+	user   *vortex.User
+	extra  map[string]interface{}
+}
+
+// Token implements oauth2.TokenSource
+func (ts *tokenSource) Token() (*oauth2.Token, error) {
+	jwt, err := ts.client.GenerateJWT(ts.user, ts.extra)
+	if err != nil {
+		return nil, err
+	}
+
+	claims, err := ts.client.VerifyJWT(jwt)
+	if err != nil {
+		return nil, err
+	}
+
+	return &oauth2.Token{
+		AccessToken: jwt,
+		TokenType:   "Bearer",
+		Expiry:      time.Unix(claims.Expires, 0),
+	}, nil
+}
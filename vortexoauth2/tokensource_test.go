@@ -0,0 +1,66 @@
+package vortexoauth2
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	vortex "github.com/TeamVortexSoftware/vortex-go-sdk"
+	"golang.org/x/oauth2"
+)
+
+func TestTokenSource(t *testing.T) {
+	client := vortex.NewClient("VRTX.EjRWeBI0EjQSNBI0VniQEg.test-key")
+	user := &vortex.User{ID: "user-123", Email: "test@example.com"}
+
+	ts := TokenSource(client, user, nil)
+	token, err := ts.Token()
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if token.TokenType != "Bearer" {
+		t.Errorf("Expected token type 'Bearer', got %s", token.TokenType)
+	}
+	if time.Until(token.Expiry) < 50*time.Minute {
+		t.Errorf("Expected expiry about an hour out, got %v", token.Expiry)
+	}
+
+	claims, err := client.VerifyJWT(token.AccessToken)
+	if err != nil {
+		t.Fatalf("Expected valid JWT, got %v", err)
+	}
+	if claims.UserID != "user-123" {
+		t.Errorf("Expected userId to be 'user-123', got %s", claims.UserID)
+	}
+
+	again, err := ts.Token()
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if again.AccessToken != token.AccessToken {
+		t.Error("Expected token to be reused until it expires")
+	}
+}
+
+func TestTokenSource_HTTPClient(t *testing.T) {
+	client := vortex.NewClient("VRTX.EjRWeBI0EjQSNBI0VniQEg.test-key")
+	user := &vortex.User{ID: "user-123", Email: "test@example.com"}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !strings.HasPrefix(r.Header.Get("Authorization"), "Bearer ") {
+			t.Errorf("Expected bearer token, got %q", r.Header.Get("Authorization"))
+		}
+	}))
+	defer server.Close()
+
+	httpClient := oauth2.NewClient(context.Background(), TokenSource(client, user, nil))
+	resp, err := httpClient.Get(server.URL)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	resp.Body.Close()
+}
@@ -0,0 +1,106 @@
+// Package vortexprometheus exports metrics about Vortex API calls to
+// Prometheus.
+//
+// Register a Collector and pass it to vortex.WithMetrics:
+//
+//	collector := vortexprometheus.NewCollector()
+//	prometheus.MustRegister(collector)
+//	client := vortex.NewClient(apiKey, vortex.WithMetrics(collector))
+//
+// It exports vortex_requests_total and vortex_request_errors_total, labelled
+// by method, endpoint and status, vortex_request_duration_seconds, labelled
+// by method and endpoint, and the vortex_requests_in_flight gauge. Endpoints
+// have their IDs replaced by "{id}", and the status of requests that got no
+// response is "error".
+package vortexprometheus
+
+import (
+	"strconv"
+	"time"
+
+	vortex "github.com/TeamVortexSoftware/vortex-go-sdk"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// Namespace prefixes the names of the exported metrics
+const Namespace = "vortex"
+
+// Collector is a vortex.MetricsCollector and a prometheus.Collector
+type Collector struct {
+	requests *prometheus.CounterVec
+	errors   *prometheus.CounterVec
+	duration *prometheus.HistogramVec
+	inFlight prometheus.Gauge
+}
+
+var _ vortex.MetricsCollector = (*Collector)(nil)
+var _ prometheus.Collector = (*Collector)(nil)
+
+// NewCollector creates a collector with the default histogram buckets
+func NewCollector() *Collector {
+	return NewCollectorWithBuckets(prometheus.DefBuckets)
+}
+
+// NewCollectorWithBuckets creates a collector whose duration histogram uses
+// the given buckets, in seconds
+func NewCollectorWithBuckets(buckets []float64) *Collector {
+	return &Collector{
+		requests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: Namespace,
+			Name:      "requests_total",
+			Help:      "Vortex API requests sent, by method, endpoint and status.",
+		}, []string{"method", "endpoint", "status"}),
+		errors: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: Namespace,
+			Name:      "request_errors_total",
+			Help:      "Vortex API requests that failed or returned a 4xx or 5xx status.",
+		}, []string{"method", "endpoint", "status"}),
+		duration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: Namespace,
+			Name:      "request_duration_seconds",
+			Help:      "Time until the Vortex API's response headers arrived.",
+			Buckets:   buckets,
+		}, []string{"method", "endpoint"}),
+		inFlight: prometheus.NewGauge(prometheus.GaugeOpts{
+			Namespace: Namespace,
+			Name:      "requests_in_flight",
+			Help:      "Vortex API requests waiting for a response.",
+		}),
+	}
+}
+
+// RequestStarted implements vortex.MetricsCollector
+func (c *Collector) RequestStarted(method, endpoint string) {
+	c.inFlight.Inc()
+}
+
+// RequestFinished implements vortex.MetricsCollector
+func (c *Collector) RequestFinished(method, endpoint string, status int, duration time.Duration) {
+	c.inFlight.Dec()
+
+	label := "error"
+	if status != 0 {
+		label = strconv.Itoa(status)
+	}
+	c.requests.WithLabelValues(method, endpoint, label).Inc()
+	if status == 0 || status >= 400 {
+		c.errors.WithLabelValues(method, endpoint, label).Inc()
+	}
+	c.duration.WithLabelValues(method, endpoint).Observe(duration.Seconds())
+}
+
+// Describe implements prometheus.Collector
+func (c *Collector) Describe(ch chan<- *prometheus.Desc) {
+	c.requests.Describe(ch)
+	c.errors.Describe(ch)
+	c.duration.Describe(ch)
+	c.inFlight.Describe(ch)
+}
+
+// Collect implements prometheus.Collector
+func (c *Collector) Collect(ch chan<- prometheus.Metric) {
+	c.requests.Collect(ch)
+	c.errors.Collect(ch)
+	c.duration.Collect(ch)
+	c.inFlight.Collect(ch)
+}
@@ -0,0 +1,60 @@
+package vortexprometheus
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	vortex "github.com/TeamVortexSoftware/vortex-go-sdk"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+func TestCollector(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/v1/invitations/missing" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Write([]byte(`{"id": "inv-1"}`))
+	}))
+	defer server.Close()
+
+	collector := NewCollector()
+	registry := prometheus.NewRegistry()
+	if err := registry.Register(collector); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	client := vortex.NewClientWithOptions("test-api-key", server.URL, nil, vortex.WithMetrics(collector))
+	client.GetInvitation("inv-1")
+	client.GetInvitation("inv-2")
+	client.GetInvitation("missing")
+
+	endpoint := "/api/v1/invitations/{id}"
+	if got := testutil.ToFloat64(collector.requests.WithLabelValues("GET", endpoint, "200")); got != 2 {
+		t.Errorf("Expected 2 successful requests, got %v", got)
+	}
+	if got := testutil.ToFloat64(collector.errors.WithLabelValues("GET", endpoint, "404")); got != 1 {
+		t.Errorf("Expected 1 error, got %v", got)
+	}
+	if got := testutil.ToFloat64(collector.inFlight); got != 0 {
+		t.Errorf("Expected no requests in flight, got %v", got)
+	}
+	if got := testutil.CollectAndCount(collector, "vortex_request_duration_seconds"); got != 1 {
+		t.Errorf("Expected 1 duration series, got %d", got)
+	}
+}
+
+func TestCollector_NetworkError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	server.Close()
+
+	collector := NewCollector()
+	client := vortex.NewClientWithOptions("test-api-key", server.URL, nil, vortex.WithMetrics(collector))
+	client.GetInvitation("inv-1")
+
+	if got := testutil.ToFloat64(collector.errors.WithLabelValues("GET", "/api/v1/invitations/{id}", "error")); got != 1 {
+		t.Errorf("Expected 1 network error, got %v", got)
+	}
+}
@@ -0,0 +1,208 @@
+// Package vortexredis provides Redis implementations of the Vortex SDK cache
+// interfaces so that multi-instance deployments share cache state.
+//
+//	rdb := redis.NewClient(&redis.Options{Addr: "localhost:6379"})
+//
+//	// Share minted JWTs between instances
+//	transport := vortex.NewTokenTransport(client, nil)
+//	transport.Cache = vortexredis.NewTokenCache(rdb, "vortex:jwt:")
+//
+//	// Share mirrored invitations between instances
+//	cache := vortexcache.New(client, vortexredis.NewStore(rdb, "vortex:cache:"), time.Minute)
+//
+//	// Share cached GET responses between instances
+//	client := vortex.NewClient(apiKey, vortex.WithCache(vortexredis.NewCache(rdb, "vortex:http:", time.Minute)))
+//
+//	// Skip webhooks another instance already handled
+//	handler := &vortexwebhooks.Handler{Secret: secret, Dedupe: vortexredis.NewWebhookDedupe(rdb, "vortex:webhook:"), Handle: handle}
+package vortexredis
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	vortex "github.com/TeamVortexSoftware/vortex-go-sdk"
+	"github.com/TeamVortexSoftware/vortex-go-sdk/vortexcache"
+	"github.com/redis/go-redis/v9"
+)
+
+// operationTimeout bounds Redis calls made through interfaces without a context
+const operationTimeout = 2 * time.Second
+
+// TokenCache is a vortex.TokenCache backed by Redis
+type TokenCache struct {
+	rdb    redis.UniversalClient
+	prefix string
+}
+
+var _ vortex.TokenCache = (*TokenCache)(nil)
+
+// NewTokenCache creates a token cache storing keys under prefix
+func NewTokenCache(rdb redis.UniversalClient, prefix string) *TokenCache {
+	return &TokenCache{rdb: rdb, prefix: prefix}
+}
+
+// Get implements vortex.TokenCache; Redis errors are treated as a cache miss
+func (c *TokenCache) Get(key string) (string, bool) {
+	ctx, cancel := context.WithTimeout(context.Background(), operationTimeout)
+	defer cancel()
+
+	token, err := c.rdb.Get(ctx, c.prefix+key).Result()
+	if err != nil {
+		return "", false
+	}
+	return token, true
+}
+
+// Set implements vortex.TokenCache; the key expires after ttl
+func (c *TokenCache) Set(key, token string, ttl time.Duration) {
+	ctx, cancel := context.WithTimeout(context.Background(), operationTimeout)
+	defer cancel()
+
+	c.rdb.Set(ctx, c.prefix+key, token, ttl)
+}
+
+// Store is a vortexcache.Store backed by Redis hashes
+type Store struct {
+	rdb    redis.UniversalClient
+	prefix string
+
+	// Expiration bounds how long entries are kept in Redis. Entries are kept
+	// past the cache ttl so they can be served when the API is unavailable;
+	// zero keeps them until they are deleted or evicted.
+	Expiration time.Duration
+}
+
+var _ vortexcache.Store = (*Store)(nil)
+
+// NewStore creates a store keeping entries under prefix
+func NewStore(rdb redis.UniversalClient, prefix string) *Store {
+	return &Store{rdb: rdb, prefix: prefix}
+}
+
+// Get implements vortexcache.Store
+func (s *Store) Get(ctx context.Context, key string) (*vortexcache.Entry, error) {
+	fields, err := s.rdb.HGetAll(ctx, s.prefix+key).Result()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read cache entry: %w", err)
+	}
+
+	value, ok := fields["value"]
+	if !ok {
+		return nil, nil
+	}
+
+	storedAt, err := strconv.ParseInt(fields["storedAt"], 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse cache entry timestamp: %w", err)
+	}
+
+	return &vortexcache.Entry{Value: []byte(value), StoredAt: time.Unix(0, storedAt)}, nil
+}
+
+// Put implements vortexcache.Store
+func (s *Store) Put(ctx context.Context, key string, value []byte) error {
+	_, err := s.rdb.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.HSet(ctx, s.prefix+key, "value", value, "storedAt", time.Now().UnixNano())
+		if s.Expiration > 0 {
+			pipe.Expire(ctx, s.prefix+key, s.Expiration)
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to write cache entry: %w", err)
+	}
+	return nil
+}
+
+// Delete implements vortexcache.Store
+func (s *Store) Delete(ctx context.Context, key string) error {
+	if err := s.rdb.Del(ctx, s.prefix+key).Err(); err != nil {
+		return fmt.Errorf("failed to delete cache entry: %w", err)
+	}
+	return nil
+}
+
+// Cache is a vortex.Cache backed by Redis
+type Cache struct {
+	rdb    redis.UniversalClient
+	prefix string
+	ttl    time.Duration
+}
+
+var _ vortex.Cache = (*Cache)(nil)
+
+// NewCache creates a response cache storing keys under prefix for ttl
+func NewCache(rdb redis.UniversalClient, prefix string, ttl time.Duration) *Cache {
+	return &Cache{rdb: rdb, prefix: prefix, ttl: ttl}
+}
+
+// Get implements vortex.Cache; Redis errors are treated as a cache miss
+func (c *Cache) Get(key string) ([]byte, bool) {
+	ctx, cancel := context.WithTimeout(context.Background(), operationTimeout)
+	defer cancel()
+
+	value, err := c.rdb.Get(ctx, c.prefix+key).Bytes()
+	if err != nil {
+		return nil, false
+	}
+	return value, true
+}
+
+// Set implements vortex.Cache
+func (c *Cache) Set(key string, value []byte) {
+	ctx, cancel := context.WithTimeout(context.Background(), operationTimeout)
+	defer cancel()
+
+	c.rdb.Set(ctx, c.prefix+key, value, c.ttl)
+}
+
+// Delete implements vortex.Cache
+func (c *Cache) Delete(key string) {
+	ctx, cancel := context.WithTimeout(context.Background(), operationTimeout)
+	defer cancel()
+
+	c.rdb.Del(ctx, c.prefix+key)
+}
+
+// Clear implements vortex.Cache by deleting every key under the prefix
+func (c *Cache) Clear() {
+	ctx, cancel := context.WithTimeout(context.Background(), operationTimeout)
+	defer cancel()
+
+	iter := c.rdb.Scan(ctx, 0, c.prefix+"*", 100).Iterator()
+	for iter.Next(ctx) {
+		c.rdb.Del(ctx, iter.Val())
+	}
+}
+
+// WebhookDedupe is a vortexwebhooks.DedupeStore backed by Redis, so an event
+// redelivered to another instance is only handled once
+type WebhookDedupe struct {
+	rdb    redis.UniversalClient
+	prefix string
+}
+
+// NewWebhookDedupe creates a dedupe store keeping event IDs under prefix
+func NewWebhookDedupe(rdb redis.UniversalClient, prefix string) *WebhookDedupe {
+	return &WebhookDedupe{rdb: rdb, prefix: prefix}
+}
+
+// Claim records eventID for ttl and reports whether it was new
+func (d *WebhookDedupe) Claim(ctx context.Context, eventID string, ttl time.Duration) (bool, error) {
+	isNew, err := d.rdb.SetNX(ctx, d.prefix+eventID, time.Now().Unix(), ttl).Result()
+	if err != nil {
+		return false, fmt.Errorf("failed to claim webhook event: %w", err)
+	}
+	return isNew, nil
+}
+
+// Release forgets eventID, so a redelivery is handled again
+func (d *WebhookDedupe) Release(ctx context.Context, eventID string) error {
+	if err := d.rdb.Del(ctx, d.prefix+eventID).Err(); err != nil {
+		return fmt.Errorf("failed to release webhook event: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,132 @@
+package vortexredis
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+)
+
+func newTestRedis(t *testing.T) (*miniredis.Miniredis, *redis.Client) {
+	mr := miniredis.RunT(t)
+	return mr, redis.NewClient(&redis.Options{Addr: mr.Addr()})
+}
+
+func TestTokenCache(t *testing.T) {
+	mr, rdb := newTestRedis(t)
+	cache := NewTokenCache(rdb, "vortex:jwt:")
+
+	if _, ok := cache.Get("user-123"); ok {
+		t.Fatal("Expected cache miss")
+	}
+
+	cache.Set("user-123", "token", time.Minute)
+
+	token, ok := cache.Get("user-123")
+	if !ok || token != "token" {
+		t.Errorf("Expected cached token, got %q (ok=%v)", token, ok)
+	}
+
+	mr.FastForward(2 * time.Minute)
+	if _, ok := cache.Get("user-123"); ok {
+		t.Error("Expected token to expire")
+	}
+}
+
+func TestStore(t *testing.T) {
+	_, rdb := newTestRedis(t)
+	store := NewStore(rdb, "vortex:cache:")
+	ctx := context.Background()
+
+	entry, err := store.Get(ctx, "invitation:inv-1")
+	if err != nil || entry != nil {
+		t.Fatalf("Expected missing entry, got %v (err=%v)", entry, err)
+	}
+
+	if err := store.Put(ctx, "invitation:inv-1", []byte(`{"id":"inv-1"}`)); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	entry, err = store.Get(ctx, "invitation:inv-1")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if string(entry.Value) != `{"id":"inv-1"}` {
+		t.Errorf("Unexpected value %s", entry.Value)
+	}
+	if time.Since(entry.StoredAt) > time.Minute {
+		t.Errorf("Unexpected storedAt %v", entry.StoredAt)
+	}
+
+	if err := store.Delete(ctx, "invitation:inv-1"); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if entry, _ := store.Get(ctx, "invitation:inv-1"); entry != nil {
+		t.Error("Expected entry to be deleted")
+	}
+}
+
+func TestCache(t *testing.T) {
+	mr, rdb := newTestRedis(t)
+	cache := NewCache(rdb, "vortex:http:", time.Minute)
+
+	if _, ok := cache.Get("invitation:inv-1"); ok {
+		t.Fatal("Expected cache miss")
+	}
+
+	cache.Set("invitation:inv-1", []byte(`{"id":"inv-1"}`))
+	cache.Set("invitation:inv-2", []byte(`{"id":"inv-2"}`))
+	rdb.Set(context.Background(), "other:key", "kept", 0)
+
+	value, ok := cache.Get("invitation:inv-1")
+	if !ok || string(value) != `{"id":"inv-1"}` {
+		t.Errorf("Expected cached value, got %q (ok=%v)", value, ok)
+	}
+
+	cache.Delete("invitation:inv-1")
+	if _, ok := cache.Get("invitation:inv-1"); ok {
+		t.Error("Expected deleted key to miss")
+	}
+
+	cache.Clear()
+	if _, ok := cache.Get("invitation:inv-2"); ok {
+		t.Error("Expected Clear to remove cached keys")
+	}
+	if !mr.Exists("other:key") {
+		t.Error("Expected Clear to keep keys outside the prefix")
+	}
+
+	cache.Set("invitation:inv-3", []byte(`{}`))
+	mr.FastForward(2 * time.Minute)
+	if _, ok := cache.Get("invitation:inv-3"); ok {
+		t.Error("Expected value to expire")
+	}
+}
+
+func TestWebhookDedupe(t *testing.T) {
+	mr, rdb := newTestRedis(t)
+	dedupe := NewWebhookDedupe(rdb, "vortex:webhook:")
+	ctx := context.Background()
+
+	isNew, err := dedupe.Claim(ctx, "evt-1", time.Hour)
+	if err != nil || !isNew {
+		t.Fatalf("Expected first claim to be new, got %v (err=%v)", isNew, err)
+	}
+	if isNew, _ := dedupe.Claim(ctx, "evt-1", time.Hour); isNew {
+		t.Error("Expected second claim to be a duplicate")
+	}
+
+	if err := dedupe.Release(ctx, "evt-1"); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if isNew, _ := dedupe.Claim(ctx, "evt-1", time.Hour); !isNew {
+		t.Error("Expected claim after release to be new")
+	}
+
+	mr.FastForward(2 * time.Hour)
+	if isNew, _ := dedupe.Claim(ctx, "evt-1", time.Hour); !isNew {
+		t.Error("Expected claim to expire")
+	}
+}
@@ -0,0 +1,16 @@
+module github.com/TeamVortexSoftware/vortex-go-sdk/vortexsentry
+
+go 1.23.0
+
+require (
+	github.com/TeamVortexSoftware/vortex-go-sdk v1.2.0
+	github.com/getsentry/sentry-go v0.40.0
+)
+
+require (
+	github.com/google/uuid v1.6.0 // indirect
+	golang.org/x/sys v0.18.0 // indirect
+	golang.org/x/text v0.14.0 // indirect
+)
+
+replace github.com/TeamVortexSoftware/vortex-go-sdk => ../
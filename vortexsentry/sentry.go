@@ -0,0 +1,76 @@
+// Package vortexsentry reports Vortex API errors to Sentry.
+//
+// Install the hook with vortex.OnError; each *vortex.APIError is captured
+// with the endpoint, status code and request ID as tags, and API keys and
+// tokens are scrubbed from the response details:
+//
+//	client := vortex.NewClient(apiKey, vortex.OnError(vortexsentry.NewHook(nil)))
+package vortexsentry
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"strconv"
+
+	vortex "github.com/TeamVortexSoftware/vortex-go-sdk"
+	"github.com/getsentry/sentry-go"
+)
+
+// NewHook returns a vortex.OnError hook capturing API errors on the hub in the
+// request context, falling back to hub and then to sentry.CurrentHub
+//
+// Other errors, such as network failures, are not reported.
+func NewHook(hub *sentry.Hub) func(ctx context.Context, err error) {
+	return func(ctx context.Context, err error) {
+		var apiErr *vortex.APIError
+		if !errors.As(err, &apiErr) {
+			return
+		}
+
+		target := sentry.GetHubFromContext(ctx)
+		if target == nil {
+			target = hub
+		}
+		if target == nil {
+			target = sentry.CurrentHub()
+		}
+
+		endpoint := apiErr.Method + " " + apiErr.Path
+		status := strconv.Itoa(apiErr.StatusCode)
+
+		target.WithScope(func(scope *sentry.Scope) {
+			scope.SetTag("vortex.endpoint", endpoint)
+			scope.SetTag("vortex.status", status)
+			if apiErr.RequestID != "" {
+				scope.SetTag("vortex.request_id", apiErr.RequestID)
+			}
+			scope.SetContext("vortex", sentry.Context{
+				"method":     apiErr.Method,
+				"path":       apiErr.Path,
+				"statusCode": apiErr.StatusCode,
+				"requestId":  apiErr.RequestID,
+				"details":    Scrub(apiErr.Details),
+			})
+			scope.SetFingerprint([]string{"vortex", endpoint, status})
+			target.CaptureException(err)
+		})
+	}
+}
+
+var secretPatterns = []*regexp.Regexp{
+	// Vortex API keys
+	regexp.MustCompile(`VRTX\.[A-Za-z0-9_-]+\.[A-Za-z0-9_-]+`),
+	// JWTs
+	regexp.MustCompile(`eyJ[A-Za-z0-9_-]*\.[A-Za-z0-9_-]+\.[A-Za-z0-9_-]+`),
+	// Bearer tokens
+	regexp.MustCompile(`(?i)bearer\s+[A-Za-z0-9._~+/=-]+`),
+}
+
+// Scrub replaces API keys, JWTs and bearer tokens in s with [Filtered]
+func Scrub(s string) string {
+	for _, pattern := range secretPatterns {
+		s = pattern.ReplaceAllString(s, "[Filtered]")
+	}
+	return s
+}
@@ -0,0 +1,83 @@
+package vortexsentry
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	vortex "github.com/TeamVortexSoftware/vortex-go-sdk"
+	"github.com/getsentry/sentry-go"
+)
+
+type recordingTransport struct {
+	mu     sync.Mutex
+	events []*sentry.Event
+}
+
+func (t *recordingTransport) Flush(timeout time.Duration) bool          { return true }
+func (t *recordingTransport) FlushWithContext(ctx context.Context) bool { return true }
+func (t *recordingTransport) Configure(options sentry.ClientOptions)    {}
+func (t *recordingTransport) Close()                                    {}
+
+func (t *recordingTransport) SendEvent(event *sentry.Event) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.events = append(t.events, event)
+}
+
+func TestNewHook(t *testing.T) {
+	transport := &recordingTransport{}
+	sentryClient, err := sentry.NewClient(sentry.ClientOptions{Transport: transport})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	hub := sentry.NewHub(sentryClient, sentry.NewScope())
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Request-Id", "req-123")
+		w.WriteHeader(http.StatusUnauthorized)
+		w.Write([]byte(`{"error":"invalid key VRTX.EjRWeBI0EjQSNBI0VniQEg.secret"}`))
+	}))
+	defer server.Close()
+
+	client := vortex.NewClientWithOptions("test-api-key", server.URL, nil, vortex.OnError(NewHook(hub)))
+	client.GetInvitation("inv-1")
+
+	if len(transport.events) != 1 {
+		t.Fatalf("Expected 1 event, got %d", len(transport.events))
+	}
+	event := transport.events[0]
+
+	if event.Tags["vortex.endpoint"] != "GET /api/v1/invitations/inv-1" {
+		t.Errorf("Expected endpoint tag, got %q", event.Tags["vortex.endpoint"])
+	}
+	if event.Tags["vortex.status"] != "401" {
+		t.Errorf("Expected status tag '401', got %q", event.Tags["vortex.status"])
+	}
+	if event.Tags["vortex.request_id"] != "req-123" {
+		t.Errorf("Expected request ID tag 'req-123', got %q", event.Tags["vortex.request_id"])
+	}
+
+	details, _ := event.Contexts["vortex"]["details"].(string)
+	if strings.Contains(details, "secret") || !strings.Contains(details, "[Filtered]") {
+		t.Errorf("Expected API key to be scrubbed from details, got %q", details)
+	}
+}
+
+func TestScrub(t *testing.T) {
+	tests := map[string]string{
+		"key VRTX.abc.def end":             "key [Filtered] end",
+		"token eyJhbGciOi.eyJ1c2Vy.sig123": "token [Filtered]",
+		"Authorization: Bearer abc.def":    "Authorization: [Filtered]",
+		"nothing secret":                   "nothing secret",
+	}
+	for input, expected := range tests {
+		if got := Scrub(input); got != expected {
+			t.Errorf("Expected %q for %q, got %q", expected, input, got)
+		}
+	}
+}
@@ -0,0 +1,167 @@
+// Package vortextemporal provides Temporal activities for invitation flows.
+//
+// Activities are plain methods, so this package does not depend on the
+// Temporal SDK. Register them on a worker and point Heartbeat at
+// activity.RecordHeartbeat so long waits report progress and are cancelled
+// promptly:
+//
+//	activities := &vortextemporal.Activities{Client: client, Heartbeat: activity.RecordHeartbeat}
+//	w.RegisterActivity(activities)
+//
+// A typical onboarding workflow waits for acceptance and revokes the
+// invitation if the wait times out:
+//
+//	ctx = workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
+//	    StartToCloseTimeout: 7 * 24 * time.Hour,
+//	    HeartbeatTimeout:    2 * time.Minute,
+//	})
+//	var invitation vortex.InvitationResult
+//	err := workflow.ExecuteActivity(ctx, activities.WaitForAcceptance,
+//	    vortextemporal.WaitInput{InvitationID: id}).Get(ctx, &invitation)
+//	if temporal.IsTimeoutError(err) {
+//	    err = workflow.ExecuteActivity(ctx, activities.RevokeIfPending, id).Get(ctx, nil)
+//	}
+//
+// CreateInvitation starts the flow. The SDK's idempotency key only covers
+// its own retries, so set IdempotencyKey to derive one from the activity, and
+// a Temporal retry after a lost response does not create a second
+// invitation:
+//
+//	activities.IdempotencyKey = func(ctx context.Context) string {
+//	    info := activity.GetInfo(ctx)
+//	    return info.WorkflowExecution.ID + "/" + info.ActivityID
+//	}
+//
+// WaitForAcceptance polls the invitation's status rather than an event feed.
+//
+// API calls are bound to the activity's context, so cancelling an activity
+// aborts the call in flight. Give the activities a client without WithCache,
+// or the status is read from the cache until its entry expires.
+package vortextemporal
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	vortex "github.com/TeamVortexSoftware/vortex-go-sdk"
+)
+
+// DefaultPollInterval is used when WaitInput.PollInterval is not set
+const DefaultPollInterval = 30 * time.Second
+
+// ErrInvitationInactive is returned by WaitForAcceptance when the invitation
+// is deactivated or expires before it is accepted
+var ErrInvitationInactive = errors.New("invitation is no longer active")
+
+// Activities holds the Vortex activities
+type Activities struct {
+	Client *vortex.Client
+
+	// Heartbeat is called after every poll with the invitation's status; set
+	// it to activity.RecordHeartbeat
+	Heartbeat func(ctx context.Context, details ...interface{})
+
+	// IdempotencyKey, if set, returns the Idempotency-Key for
+	// CreateInvitation, so retries of the activity reuse it
+	IdempotencyKey func(ctx context.Context) string
+}
+
+// WaitInput is the input of WaitForAcceptance
+type WaitInput struct {
+	InvitationID string
+	PollInterval time.Duration
+}
+
+// WaitForAcceptance polls the invitation until it is accepted and returns it
+//
+// The activity returns ErrInvitationInactive if the invitation is deactivated
+// or expires, and the context error when the activity times out or is
+// cancelled. Transient API errors are retried on the next poll.
+func (a *Activities) WaitForAcceptance(ctx context.Context, input WaitInput) (*vortex.InvitationResult, error) {
+	interval := input.PollInterval
+	if interval <= 0 {
+		interval = DefaultPollInterval
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		invitation, err := a.Client.GetInvitationContext(ctx, input.InvitationID)
+		if err == nil {
+			if isAccepted(invitation) {
+				return invitation, nil
+			}
+			if invitation.Deactivated || invitation.Expired {
+				return nil, ErrInvitationInactive
+			}
+			a.heartbeat(ctx, invitation.Status)
+		} else {
+			var apiErr *vortex.APIError
+			if errors.As(err, &apiErr) && apiErr.StatusCode == 404 {
+				return nil, fmt.Errorf("%w: %v", ErrInvitationInactive, err)
+			}
+			a.heartbeat(ctx, err.Error())
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// CreateInvitation creates an invitation and returns it
+func (a *Activities) CreateInvitation(ctx context.Context, req vortex.CreateInvitationRequest) (*vortex.InvitationResult, error) {
+	var opts []vortex.RequestOption
+	if a.IdempotencyKey != nil {
+		opts = append(opts, vortex.IdempotencyKey(a.IdempotencyKey(ctx)))
+	}
+	return a.Client.CreateInvitation(ctx, req, opts...)
+}
+
+// RevokeInvitation revokes an invitation; an invitation that no longer
+// exists counts as revoked so the activity is safe to retry
+func (a *Activities) RevokeInvitation(ctx context.Context, invitationID string) error {
+	err := a.Client.RevokeInvitationContext(ctx, invitationID)
+
+	var apiErr *vortex.APIError
+	if errors.As(err, &apiErr) && apiErr.StatusCode == 404 {
+		return nil
+	}
+	return err
+}
+
+// RevokeIfPending revokes an invitation unless it has been accepted in the
+// meantime, and reports whether it was revoked
+func (a *Activities) RevokeIfPending(ctx context.Context, invitationID string) (bool, error) {
+	invitation, err := a.Client.GetInvitationContext(ctx, invitationID)
+	if err != nil {
+		var apiErr *vortex.APIError
+		if errors.As(err, &apiErr) && apiErr.StatusCode == 404 {
+			return false, nil
+		}
+		return false, err
+	}
+	if isAccepted(invitation) {
+		return false, nil
+	}
+
+	if err := a.RevokeInvitation(ctx, invitationID); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func (a *Activities) heartbeat(ctx context.Context, details ...interface{}) {
+	if a.Heartbeat != nil {
+		a.Heartbeat(ctx, details...)
+	}
+}
+
+func isAccepted(invitation *vortex.InvitationResult) bool {
+	return invitation.Status == vortex.StatusAccepted || len(invitation.Accepts) > 0
+}
@@ -0,0 +1,129 @@
+package vortextemporal
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	vortex "github.com/TeamVortexSoftware/vortex-go-sdk"
+)
+
+func TestWaitForAcceptance(t *testing.T) {
+	polls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		polls++
+		status := vortex.StatusDelivered
+		if polls >= 3 {
+			status = vortex.StatusAccepted
+		}
+		json.NewEncoder(w).Encode(vortex.InvitationResult{ID: "inv-1", Status: status})
+	}))
+	defer server.Close()
+
+	heartbeats := 0
+	activities := &Activities{
+		Client:    vortex.NewClientWithOptions("test-api-key", server.URL, nil),
+		Heartbeat: func(ctx context.Context, details ...interface{}) { heartbeats++ },
+	}
+
+	invitation, err := activities.WaitForAcceptance(context.Background(), WaitInput{
+		InvitationID: "inv-1",
+		PollInterval: time.Millisecond,
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if invitation.Status != "accepted" {
+		t.Errorf("Expected accepted invitation, got %s", invitation.Status)
+	}
+	if heartbeats != 2 {
+		t.Errorf("Expected 2 heartbeats, got %d", heartbeats)
+	}
+}
+
+func TestWaitForAcceptance_Inactive(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(vortex.InvitationResult{ID: "inv-1", Deactivated: true})
+	}))
+	defer server.Close()
+
+	activities := &Activities{Client: vortex.NewClientWithOptions("test-api-key", server.URL, nil)}
+
+	_, err := activities.WaitForAcceptance(context.Background(), WaitInput{InvitationID: "inv-1"})
+	if !errors.Is(err, ErrInvitationInactive) {
+		t.Errorf("Expected ErrInvitationInactive, got %v", err)
+	}
+}
+
+func TestWaitForAcceptance_Cancelled(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(vortex.InvitationResult{ID: "inv-1", Status: "delivered"})
+	}))
+	defer server.Close()
+
+	activities := &Activities{Client: vortex.NewClientWithOptions("test-api-key", server.URL, nil)}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	_, err := activities.WaitForAcceptance(ctx, WaitInput{InvitationID: "inv-1", PollInterval: time.Millisecond})
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Expected context.DeadlineExceeded, got %v", err)
+	}
+}
+
+func TestRevokeIfPending(t *testing.T) {
+	revoked := false
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "DELETE" {
+			revoked = true
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		json.NewEncoder(w).Encode(vortex.InvitationResult{ID: "inv-1", Status: "delivered"})
+	}))
+	defer server.Close()
+
+	activities := &Activities{Client: vortex.NewClientWithOptions("test-api-key", server.URL, nil)}
+
+	ok, err := activities.RevokeIfPending(context.Background(), "inv-1")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if !ok || !revoked {
+		t.Error("Expected pending invitation to be revoked")
+	}
+}
+
+func TestCreateInvitation(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "POST" || r.URL.Path != "/api/v1/invitations" {
+			t.Errorf("Expected POST /api/v1/invitations, got %s %s", r.Method, r.URL.Path)
+		}
+		if r.Header.Get("Idempotency-Key") != "wf-1/3" {
+			t.Errorf("Expected Idempotency-Key wf-1/3, got %q", r.Header.Get("Idempotency-Key"))
+		}
+		json.NewEncoder(w).Encode(vortex.InvitationResult{ID: "inv-1", Status: "queued"})
+	}))
+	defer server.Close()
+
+	activities := &Activities{
+		Client:         vortex.NewClientWithOptions("test-api-key", server.URL, nil),
+		IdempotencyKey: func(ctx context.Context) string { return "wf-1/3" },
+	}
+
+	invitation, err := activities.CreateInvitation(context.Background(), vortex.CreateInvitationRequest{
+		WidgetConfigurationID: "wc-1",
+		Targets:               []vortex.InvitationTarget{{Type: "email", Value: "user@example.com"}},
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if invitation.ID != "inv-1" {
+		t.Errorf("Expected invitation inv-1, got %s", invitation.ID)
+	}
+}
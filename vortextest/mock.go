@@ -0,0 +1,820 @@
+// Package vortextest provides a programmable stand-in for the Vortex client,
+// so code written against vortex.VortexClient can be unit tested without an
+// HTTP server, and Server, an in-memory fake of the API for integration tests.
+//
+//	mock := &vortextest.MockClient{
+//	    GetInvitationFunc: func(id string, opts ...vortex.RequestOption) (*vortex.InvitationResult, error) {
+//	        return &vortex.InvitationResult{ID: id, Status: "accepted"}, nil
+//	    },
+//	}
+//	handler := NewHandler(mock)
+//	// ... exercise handler ...
+//	if calls := mock.CallsTo("GetInvitation"); len(calls) != 1 {
+//	    t.Errorf("Expected one lookup, got %d", len(calls))
+//	}
+package vortextest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+
+	vortex "github.com/TeamVortexSoftware/vortex-go-sdk"
+)
+
+// ErrNotMocked is returned by methods whose Func field is not set
+var ErrNotMocked = errors.New("vortextest: method not mocked")
+
+// Call is a recorded method call
+type Call struct {
+	Method string
+	// Args are the call's arguments, leaving out the context
+	Args []interface{}
+}
+
+// MockClient implements vortex.VortexClient by calling the Func field named
+// after each method. Methods whose field is nil fail with ErrNotMocked. Every
+// call is recorded, and a MockClient is safe for concurrent use as long as
+// the fields are not changed while it is in use.
+type MockClient struct {
+	AcceptInvitationsFunc               func(invitationIDs []string, target vortex.InvitationTarget, opts ...vortex.RequestOption) (*vortex.InvitationResult, error)
+	AcceptInvitationsAsAdminFunc        func(ctx context.Context, invitationIDs []string, target vortex.InvitationTarget, actor vortex.Actor, opts ...vortex.RequestOption) (*vortex.InvitationResult, error)
+	AcceptInvitationsBatchFunc          func(ctx context.Context, invitationIDs []string, target vortex.InvitationTarget, opts ...vortex.RequestOption) ([]vortex.AcceptResult, error)
+	AcceptInvitationsContextFunc        func(ctx context.Context, invitationIDs []string, target vortex.InvitationTarget, opts ...vortex.RequestOption) (*vortex.InvitationResult, error)
+	AddGroupMembersFunc                 func(ctx context.Context, groupType, groupID string, members []vortex.Member, opts ...vortex.RequestOption) error
+	AddInvitationTagsFunc               func(ctx context.Context, invitationID string, tags ...string) (*vortex.InvitationResult, error)
+	ApproveInvitationFunc               func(ctx context.Context, invitationID string, reviewer vortex.Actor, opts ...vortex.RequestOption) (*vortex.InvitationResult, error)
+	ArchiveInvitationFunc               func(ctx context.Context, invitationID string, opts ...vortex.RequestOption) (*vortex.InvitationResult, error)
+	AttachFileToInvitationFunc          func(ctx context.Context, invitationID, filename string, r io.Reader, opts ...vortex.AttachmentOption) (*vortex.Attachment, error)
+	CancelReminderFunc                  func(ctx context.Context, invitationID, reminderID string, opts ...vortex.RequestOption) error
+	CloneInvitationFunc                 func(ctx context.Context, invitationID string, overrides vortex.InvitationOverrides, opts ...vortex.RequestOption) (*vortex.InvitationResult, error)
+	CreateGroupInviteLinkFunc           func(ctx context.Context, group vortex.GroupRef, options vortex.GroupInviteLinkOptions, opts ...vortex.RequestOption) (*vortex.GroupInviteLink, error)
+	CreateInvitationFunc                func(ctx context.Context, req vortex.CreateInvitationRequest, opts ...vortex.RequestOption) (*vortex.InvitationResult, error)
+	DeleteGroupPolicyFunc               func(ctx context.Context, group vortex.GroupRef, opts ...vortex.RequestOption) error
+	DeleteInvitationsByGroupFunc        func(groupType, groupID string, opts ...vortex.RequestOption) error
+	DeleteInvitationsByGroupContextFunc func(ctx context.Context, groupType, groupID string, opts ...vortex.RequestOption) error
+	DoFunc                              func(ctx context.Context, req vortex.Request) (*vortex.Response, error)
+	DoJSONFunc                          func(ctx context.Context, method, path string, body, out interface{}, opts ...vortex.RequestOption) error
+	EraseTargetDataFunc                 func(ctx context.Context, target vortex.InvitationTarget, opts ...vortex.RequestOption) (*vortex.ErasureReport, error)
+	ExportTargetDataFunc                func(ctx context.Context, target vortex.InvitationTarget, w io.Writer, opts ...vortex.RequestOption) error
+	GenerateJWTFunc                     func(user *vortex.User, extra map[string]interface{}) (string, error)
+	GenerateJWTWithOptionsFunc          func(user *vortex.User, extra map[string]interface{}, opts vortex.JWTOptions) (string, error)
+	GetGroupPolicyFunc                  func(ctx context.Context, group vortex.GroupRef, opts ...vortex.RequestOption) (*vortex.GroupPolicy, error)
+	GetInvitationFunc                   func(invitationID string, opts ...vortex.RequestOption) (*vortex.InvitationResult, error)
+	GetInvitationContextFunc            func(ctx context.Context, invitationID string, opts ...vortex.RequestOption) (*vortex.InvitationResult, error)
+	GetInvitationDeliveriesFunc         func(ctx context.Context, invitationID string, opts ...vortex.RequestOption) ([]vortex.Delivery, error)
+	GetInvitationEventsFunc             func(ctx context.Context, invitationID string, opts ...vortex.RequestOption) ([]vortex.InvitationHistoryEvent, error)
+	GetInvitationLimitsFunc             func(ctx context.Context, opts ...vortex.RequestOption) (*vortex.InvitationLimits, error)
+	GetInvitationLinkFunc               func(ctx context.Context, invitationID string, opts ...vortex.RequestOption) (string, error)
+	GetInvitationStatsFunc              func(ctx context.Context, filters vortex.StatsOptions, opts ...vortex.RequestOption) (*vortex.InvitationStats, error)
+	GetInvitationTimeSeriesFunc         func(ctx context.Context, metric, interval string, filters vortex.TimeSeriesFilters, opts ...vortex.RequestOption) ([]vortex.TimeSeriesBucket, error)
+	GetInvitationsByGroupFunc           func(groupType, groupID string, opts ...vortex.ListOption) ([]vortex.InvitationResult, error)
+	GetInvitationsByGroupContextFunc    func(ctx context.Context, groupType, groupID string, opts ...vortex.ListOption) ([]vortex.InvitationResult, error)
+	GetInvitationsByTargetFunc          func(targetType, targetValue string, opts ...vortex.ListOption) ([]vortex.InvitationResult, error)
+	GetInvitationsByTargetContextFunc   func(ctx context.Context, targetType, targetValue string, opts ...vortex.ListOption) ([]vortex.InvitationResult, error)
+	GetInvitationsForTargetFunc         func(ctx context.Context, target vortex.InvitationTarget, opts ...vortex.ListOption) ([]vortex.InvitationResult, error)
+	GetLandingPageFunc                  func(ctx context.Context, widgetConfigurationID string, opts ...vortex.RequestOption) (*vortex.LandingPage, error)
+	GetSMSSettingsFunc                  func(ctx context.Context, opts ...vortex.RequestOption) (*vortex.SMSSettings, error)
+	GetSeatUsageFunc                    func(ctx context.Context, group vortex.GroupRef, opts ...vortex.RequestOption) (*vortex.SeatUsage, error)
+	GetThrottleSettingsFunc             func(ctx context.Context, opts ...vortex.RequestOption) (*vortex.ThrottleSettings, error)
+	GetThrottleStateFunc                func(ctx context.Context, inviterID string, opts ...vortex.RequestOption) (*vortex.ThrottleState, error)
+	GetVariantMetricsFunc               func(ctx context.Context, widgetConfigurationID string, opts ...vortex.RequestOption) ([]vortex.VariantMetrics, error)
+	GetWidgetBootstrapFunc              func(ctx context.Context, user *vortex.User, group vortex.GroupRef, opts ...vortex.RequestOption) (*vortex.WidgetBootstrap, error)
+	GraphQLFunc                         func(ctx context.Context, query string, vars map[string]interface{}, out interface{}, opts ...vortex.RequestOption) error
+	LastRateLimitFunc                   func() *vortex.RateLimit
+	LastResponseFunc                    func() *vortex.ResponseMetadata
+	ListGroupInviteLinksFunc            func(ctx context.Context, group vortex.GroupRef, opts ...vortex.RequestOption) ([]vortex.GroupInviteLink, error)
+	ListGroupMembersFunc                func(ctx context.Context, groupType, groupID string, opts ...vortex.RequestOption) *vortex.MemberIterator
+	ListInvitationsFunc                 func(ctx context.Context, opts vortex.ListOptions) *vortex.InvitationIterator
+	ListRemindersFunc                   func(ctx context.Context, invitationID string, opts ...vortex.RequestOption) ([]vortex.Reminder, error)
+	ListTemplateLocalizationsFunc       func(ctx context.Context, templateID string, opts ...vortex.RequestOption) ([]vortex.TemplateLocalization, error)
+	MoveInvitationFunc                  func(ctx context.Context, invitationID string, fromGroup, toGroup vortex.GroupRef, opts ...vortex.RequestOption) (*vortex.InvitationResult, error)
+	PatchInvitationAttributesFunc       func(ctx context.Context, invitationID string, attrs map[string]interface{}, opts ...vortex.RequestOption) (*vortex.InvitationResult, error)
+	PreviewInvitationEmailFunc          func(ctx context.Context, templateID string, sampleData map[string]interface{}, opts ...vortex.RequestOption) (*vortex.EmailPreview, error)
+	ReinviteFunc                        func(invitationID string, opts ...vortex.RequestOption) (*vortex.InvitationResult, error)
+	ReinviteContextFunc                 func(ctx context.Context, invitationID string, opts ...vortex.RequestOption) (*vortex.InvitationResult, error)
+	ReinviteManyFunc                    func(ctx context.Context, invitationIDs []string, opts ...vortex.RequestOption) (*vortex.BulkResult, error)
+	RejectInvitationFunc                func(ctx context.Context, invitationID string, reviewer vortex.Actor, opts ...vortex.RequestOption) (*vortex.InvitationResult, error)
+	RemoveGroupMemberFunc               func(ctx context.Context, groupType, groupID, userID string, opts ...vortex.RequestOption) error
+	RemoveInvitationTagsFunc            func(ctx context.Context, invitationID string, tags ...string) (*vortex.InvitationResult, error)
+	RemoveSuppressionFunc               func(ctx context.Context, target vortex.InvitationTarget, opts ...vortex.RequestOption) error
+	RestoreInvitationFunc               func(ctx context.Context, invitationID string, opts ...vortex.RequestOption) (*vortex.InvitationResult, error)
+	RetryDeliveryFunc                   func(ctx context.Context, invitationID, deliveryID string, opts ...vortex.RequestOption) (*vortex.Delivery, error)
+	RevokeAllForTargetFunc              func(ctx context.Context, target vortex.InvitationTarget, opts ...vortex.RequestOption) ([]string, error)
+	RevokeGroupInviteLinkFunc           func(ctx context.Context, linkID string, opts ...vortex.RequestOption) error
+	RevokeInvitationFunc                func(invitationID string, opts ...vortex.RequestOption) error
+	RevokeInvitationContextFunc         func(ctx context.Context, invitationID string, opts ...vortex.RequestOption) error
+	RevokeInvitationsFunc               func(ctx context.Context, invitationIDs []string, opts ...vortex.RequestOption) (*vortex.BulkResult, error)
+	RotateGroupInviteLinkFunc           func(ctx context.Context, linkID string, opts ...vortex.RequestOption) (*vortex.GroupInviteLink, error)
+	ScheduleRemindersFunc               func(ctx context.Context, invitationID string, delays ...time.Duration) ([]vortex.Reminder, error)
+	SetGroupPolicyFunc                  func(ctx context.Context, group vortex.GroupRef, policy vortex.GroupPolicy, opts ...vortex.RequestOption) (*vortex.GroupPolicy, error)
+	SetReferralRewardStateFunc          func(ctx context.Context, invitationID, state string, opts ...vortex.RequestOption) (*vortex.InvitationResult, error)
+	ShortenLinkFunc                     func(ctx context.Context, longURL string, opts ...vortex.RequestOption) (string, error)
+	StreamEventsFunc                    func(ctx context.Context, opts vortex.StreamOptions) (<-chan vortex.Event, error)
+	SuppressHardBounceFunc              func(ctx context.Context, event vortex.DeliveryBouncedEvent, opts ...vortex.RequestOption) (bool, error)
+	SuppressTargetFunc                  func(ctx context.Context, target vortex.InvitationTarget, reason string, opts ...vortex.RequestOption) error
+	UpdateLandingPageFunc               func(ctx context.Context, widgetConfigurationID string, page vortex.LandingPage, opts ...vortex.RequestOption) (*vortex.LandingPage, error)
+	UpdateSMSSettingsFunc               func(ctx context.Context, settings vortex.SMSSettings, opts ...vortex.RequestOption) (*vortex.SMSSettings, error)
+	UpdateThrottleSettingsFunc          func(ctx context.Context, settings vortex.ThrottleSettings, opts ...vortex.RequestOption) (*vortex.ThrottleSettings, error)
+	UseFunc                             func(middleware ...vortex.Middleware)
+	VerifyJWTFunc                       func(token string) (*vortex.JWTClaims, error)
+
+	mu    sync.Mutex
+	calls []Call
+}
+
+var _ vortex.VortexClient = (*MockClient)(nil)
+
+// Calls returns the calls made so far, in order
+func (m *MockClient) Calls() []Call {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]Call(nil), m.calls...)
+}
+
+// CallsTo returns the calls made so far to one method
+func (m *MockClient) CallsTo(method string) []Call {
+	var calls []Call
+	for _, call := range m.Calls() {
+		if call.Method == method {
+			calls = append(calls, call)
+		}
+	}
+	return calls
+}
+
+// Reset forgets the recorded calls
+func (m *MockClient) Reset() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.calls = nil
+}
+
+func (m *MockClient) record(method string, args ...interface{}) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.calls = append(m.calls, Call{Method: method, Args: args})
+}
+
+func notMocked(method string) error {
+	return fmt.Errorf("%w: %s", ErrNotMocked, method)
+}
+
+func (m *MockClient) AcceptInvitations(invitationIDs []string, target vortex.InvitationTarget, opts ...vortex.RequestOption) (*vortex.InvitationResult, error) {
+	m.record("AcceptInvitations", invitationIDs, target, opts)
+	if m.AcceptInvitationsFunc != nil {
+		return m.AcceptInvitationsFunc(invitationIDs, target, opts...)
+	}
+	return nil, notMocked("AcceptInvitations")
+}
+
+func (m *MockClient) AcceptInvitationsAsAdmin(ctx context.Context, invitationIDs []string, target vortex.InvitationTarget, actor vortex.Actor, opts ...vortex.RequestOption) (*vortex.InvitationResult, error) {
+	m.record("AcceptInvitationsAsAdmin", invitationIDs, target, actor, opts)
+	if m.AcceptInvitationsAsAdminFunc != nil {
+		return m.AcceptInvitationsAsAdminFunc(ctx, invitationIDs, target, actor, opts...)
+	}
+	return nil, notMocked("AcceptInvitationsAsAdmin")
+}
+
+func (m *MockClient) AcceptInvitationsBatch(ctx context.Context, invitationIDs []string, target vortex.InvitationTarget, opts ...vortex.RequestOption) ([]vortex.AcceptResult, error) {
+	m.record("AcceptInvitationsBatch", invitationIDs, target, opts)
+	if m.AcceptInvitationsBatchFunc != nil {
+		return m.AcceptInvitationsBatchFunc(ctx, invitationIDs, target, opts...)
+	}
+	return nil, notMocked("AcceptInvitationsBatch")
+}
+
+func (m *MockClient) AcceptInvitationsContext(ctx context.Context, invitationIDs []string, target vortex.InvitationTarget, opts ...vortex.RequestOption) (*vortex.InvitationResult, error) {
+	m.record("AcceptInvitationsContext", invitationIDs, target, opts)
+	if m.AcceptInvitationsContextFunc != nil {
+		return m.AcceptInvitationsContextFunc(ctx, invitationIDs, target, opts...)
+	}
+	return nil, notMocked("AcceptInvitationsContext")
+}
+
+func (m *MockClient) AddGroupMembers(ctx context.Context, groupType, groupID string, members []vortex.Member, opts ...vortex.RequestOption) error {
+	m.record("AddGroupMembers", groupType, groupID, members, opts)
+	if m.AddGroupMembersFunc != nil {
+		return m.AddGroupMembersFunc(ctx, groupType, groupID, members, opts...)
+	}
+	return notMocked("AddGroupMembers")
+}
+
+func (m *MockClient) AddInvitationTags(ctx context.Context, invitationID string, tags ...string) (*vortex.InvitationResult, error) {
+	m.record("AddInvitationTags", invitationID, tags)
+	if m.AddInvitationTagsFunc != nil {
+		return m.AddInvitationTagsFunc(ctx, invitationID, tags...)
+	}
+	return nil, notMocked("AddInvitationTags")
+}
+
+func (m *MockClient) ApproveInvitation(ctx context.Context, invitationID string, reviewer vortex.Actor, opts ...vortex.RequestOption) (*vortex.InvitationResult, error) {
+	m.record("ApproveInvitation", invitationID, reviewer, opts)
+	if m.ApproveInvitationFunc != nil {
+		return m.ApproveInvitationFunc(ctx, invitationID, reviewer, opts...)
+	}
+	return nil, notMocked("ApproveInvitation")
+}
+
+func (m *MockClient) ArchiveInvitation(ctx context.Context, invitationID string, opts ...vortex.RequestOption) (*vortex.InvitationResult, error) {
+	m.record("ArchiveInvitation", invitationID, opts)
+	if m.ArchiveInvitationFunc != nil {
+		return m.ArchiveInvitationFunc(ctx, invitationID, opts...)
+	}
+	return nil, notMocked("ArchiveInvitation")
+}
+
+func (m *MockClient) AttachFileToInvitation(ctx context.Context, invitationID, filename string, r io.Reader, opts ...vortex.AttachmentOption) (*vortex.Attachment, error) {
+	m.record("AttachFileToInvitation", invitationID, filename, r, opts)
+	if m.AttachFileToInvitationFunc != nil {
+		return m.AttachFileToInvitationFunc(ctx, invitationID, filename, r, opts...)
+	}
+	return nil, notMocked("AttachFileToInvitation")
+}
+
+func (m *MockClient) CancelReminder(ctx context.Context, invitationID, reminderID string, opts ...vortex.RequestOption) error {
+	m.record("CancelReminder", invitationID, reminderID, opts)
+	if m.CancelReminderFunc != nil {
+		return m.CancelReminderFunc(ctx, invitationID, reminderID, opts...)
+	}
+	return notMocked("CancelReminder")
+}
+
+func (m *MockClient) CloneInvitation(ctx context.Context, invitationID string, overrides vortex.InvitationOverrides, opts ...vortex.RequestOption) (*vortex.InvitationResult, error) {
+	m.record("CloneInvitation", invitationID, overrides, opts)
+	if m.CloneInvitationFunc != nil {
+		return m.CloneInvitationFunc(ctx, invitationID, overrides, opts...)
+	}
+	return nil, notMocked("CloneInvitation")
+}
+
+func (m *MockClient) CreateGroupInviteLink(ctx context.Context, group vortex.GroupRef, options vortex.GroupInviteLinkOptions, opts ...vortex.RequestOption) (*vortex.GroupInviteLink, error) {
+	m.record("CreateGroupInviteLink", group, options, opts)
+	if m.CreateGroupInviteLinkFunc != nil {
+		return m.CreateGroupInviteLinkFunc(ctx, group, options, opts...)
+	}
+	return nil, notMocked("CreateGroupInviteLink")
+}
+
+func (m *MockClient) CreateInvitation(ctx context.Context, req vortex.CreateInvitationRequest, opts ...vortex.RequestOption) (*vortex.InvitationResult, error) {
+	m.record("CreateInvitation", req, opts)
+	if m.CreateInvitationFunc != nil {
+		return m.CreateInvitationFunc(ctx, req, opts...)
+	}
+	return nil, notMocked("CreateInvitation")
+}
+
+func (m *MockClient) DeleteGroupPolicy(ctx context.Context, group vortex.GroupRef, opts ...vortex.RequestOption) error {
+	m.record("DeleteGroupPolicy", group, opts)
+	if m.DeleteGroupPolicyFunc != nil {
+		return m.DeleteGroupPolicyFunc(ctx, group, opts...)
+	}
+	return notMocked("DeleteGroupPolicy")
+}
+
+func (m *MockClient) DeleteInvitationsByGroup(groupType, groupID string, opts ...vortex.RequestOption) error {
+	m.record("DeleteInvitationsByGroup", groupType, groupID, opts)
+	if m.DeleteInvitationsByGroupFunc != nil {
+		return m.DeleteInvitationsByGroupFunc(groupType, groupID, opts...)
+	}
+	return notMocked("DeleteInvitationsByGroup")
+}
+
+func (m *MockClient) DeleteInvitationsByGroupContext(ctx context.Context, groupType, groupID string, opts ...vortex.RequestOption) error {
+	m.record("DeleteInvitationsByGroupContext", groupType, groupID, opts)
+	if m.DeleteInvitationsByGroupContextFunc != nil {
+		return m.DeleteInvitationsByGroupContextFunc(ctx, groupType, groupID, opts...)
+	}
+	return notMocked("DeleteInvitationsByGroupContext")
+}
+
+func (m *MockClient) Do(ctx context.Context, req vortex.Request) (*vortex.Response, error) {
+	m.record("Do", req)
+	if m.DoFunc != nil {
+		return m.DoFunc(ctx, req)
+	}
+	return nil, notMocked("Do")
+}
+
+func (m *MockClient) DoJSON(ctx context.Context, method, path string, body, out interface{}, opts ...vortex.RequestOption) error {
+	m.record("DoJSON", method, path, body, out, opts)
+	if m.DoJSONFunc != nil {
+		return m.DoJSONFunc(ctx, method, path, body, out, opts...)
+	}
+	return notMocked("DoJSON")
+}
+
+func (m *MockClient) EraseTargetData(ctx context.Context, target vortex.InvitationTarget, opts ...vortex.RequestOption) (*vortex.ErasureReport, error) {
+	m.record("EraseTargetData", target, opts)
+	if m.EraseTargetDataFunc != nil {
+		return m.EraseTargetDataFunc(ctx, target, opts...)
+	}
+	return nil, notMocked("EraseTargetData")
+}
+
+func (m *MockClient) ExportTargetData(ctx context.Context, target vortex.InvitationTarget, w io.Writer, opts ...vortex.RequestOption) error {
+	m.record("ExportTargetData", target, w, opts)
+	if m.ExportTargetDataFunc != nil {
+		return m.ExportTargetDataFunc(ctx, target, w, opts...)
+	}
+	return notMocked("ExportTargetData")
+}
+
+func (m *MockClient) GenerateJWT(user *vortex.User, extra map[string]interface{}) (string, error) {
+	m.record("GenerateJWT", user, extra)
+	if m.GenerateJWTFunc != nil {
+		return m.GenerateJWTFunc(user, extra)
+	}
+	return "", notMocked("GenerateJWT")
+}
+
+func (m *MockClient) GenerateJWTWithOptions(user *vortex.User, extra map[string]interface{}, opts vortex.JWTOptions) (string, error) {
+	m.record("GenerateJWTWithOptions", user, extra, opts)
+	if m.GenerateJWTWithOptionsFunc != nil {
+		return m.GenerateJWTWithOptionsFunc(user, extra, opts)
+	}
+	return "", notMocked("GenerateJWTWithOptions")
+}
+
+func (m *MockClient) GetGroupPolicy(ctx context.Context, group vortex.GroupRef, opts ...vortex.RequestOption) (*vortex.GroupPolicy, error) {
+	m.record("GetGroupPolicy", group, opts)
+	if m.GetGroupPolicyFunc != nil {
+		return m.GetGroupPolicyFunc(ctx, group, opts...)
+	}
+	return nil, notMocked("GetGroupPolicy")
+}
+
+func (m *MockClient) GetInvitation(invitationID string, opts ...vortex.RequestOption) (*vortex.InvitationResult, error) {
+	m.record("GetInvitation", invitationID, opts)
+	if m.GetInvitationFunc != nil {
+		return m.GetInvitationFunc(invitationID, opts...)
+	}
+	return nil, notMocked("GetInvitation")
+}
+
+func (m *MockClient) GetInvitationContext(ctx context.Context, invitationID string, opts ...vortex.RequestOption) (*vortex.InvitationResult, error) {
+	m.record("GetInvitationContext", invitationID, opts)
+	if m.GetInvitationContextFunc != nil {
+		return m.GetInvitationContextFunc(ctx, invitationID, opts...)
+	}
+	return nil, notMocked("GetInvitationContext")
+}
+
+func (m *MockClient) GetInvitationDeliveries(ctx context.Context, invitationID string, opts ...vortex.RequestOption) ([]vortex.Delivery, error) {
+	m.record("GetInvitationDeliveries", invitationID, opts)
+	if m.GetInvitationDeliveriesFunc != nil {
+		return m.GetInvitationDeliveriesFunc(ctx, invitationID, opts...)
+	}
+	return nil, notMocked("GetInvitationDeliveries")
+}
+
+func (m *MockClient) GetInvitationEvents(ctx context.Context, invitationID string, opts ...vortex.RequestOption) ([]vortex.InvitationHistoryEvent, error) {
+	m.record("GetInvitationEvents", invitationID, opts)
+	if m.GetInvitationEventsFunc != nil {
+		return m.GetInvitationEventsFunc(ctx, invitationID, opts...)
+	}
+	return nil, notMocked("GetInvitationEvents")
+}
+
+func (m *MockClient) GetInvitationLimits(ctx context.Context, opts ...vortex.RequestOption) (*vortex.InvitationLimits, error) {
+	m.record("GetInvitationLimits", opts)
+	if m.GetInvitationLimitsFunc != nil {
+		return m.GetInvitationLimitsFunc(ctx, opts...)
+	}
+	return nil, notMocked("GetInvitationLimits")
+}
+
+func (m *MockClient) GetInvitationLink(ctx context.Context, invitationID string, opts ...vortex.RequestOption) (string, error) {
+	m.record("GetInvitationLink", invitationID, opts)
+	if m.GetInvitationLinkFunc != nil {
+		return m.GetInvitationLinkFunc(ctx, invitationID, opts...)
+	}
+	return "", notMocked("GetInvitationLink")
+}
+
+func (m *MockClient) GetInvitationStats(ctx context.Context, filters vortex.StatsOptions, opts ...vortex.RequestOption) (*vortex.InvitationStats, error) {
+	m.record("GetInvitationStats", filters, opts)
+	if m.GetInvitationStatsFunc != nil {
+		return m.GetInvitationStatsFunc(ctx, filters, opts...)
+	}
+	return nil, notMocked("GetInvitationStats")
+}
+
+func (m *MockClient) GetInvitationTimeSeries(ctx context.Context, metric, interval string, filters vortex.TimeSeriesFilters, opts ...vortex.RequestOption) ([]vortex.TimeSeriesBucket, error) {
+	m.record("GetInvitationTimeSeries", metric, interval, filters, opts)
+	if m.GetInvitationTimeSeriesFunc != nil {
+		return m.GetInvitationTimeSeriesFunc(ctx, metric, interval, filters, opts...)
+	}
+	return nil, notMocked("GetInvitationTimeSeries")
+}
+
+func (m *MockClient) GetInvitationsByGroup(groupType, groupID string, opts ...vortex.ListOption) ([]vortex.InvitationResult, error) {
+	m.record("GetInvitationsByGroup", groupType, groupID, opts)
+	if m.GetInvitationsByGroupFunc != nil {
+		return m.GetInvitationsByGroupFunc(groupType, groupID, opts...)
+	}
+	return nil, notMocked("GetInvitationsByGroup")
+}
+
+func (m *MockClient) GetInvitationsByGroupContext(ctx context.Context, groupType, groupID string, opts ...vortex.ListOption) ([]vortex.InvitationResult, error) {
+	m.record("GetInvitationsByGroupContext", groupType, groupID, opts)
+	if m.GetInvitationsByGroupContextFunc != nil {
+		return m.GetInvitationsByGroupContextFunc(ctx, groupType, groupID, opts...)
+	}
+	return nil, notMocked("GetInvitationsByGroupContext")
+}
+
+func (m *MockClient) GetInvitationsByTarget(targetType, targetValue string, opts ...vortex.ListOption) ([]vortex.InvitationResult, error) {
+	m.record("GetInvitationsByTarget", targetType, targetValue, opts)
+	if m.GetInvitationsByTargetFunc != nil {
+		return m.GetInvitationsByTargetFunc(targetType, targetValue, opts...)
+	}
+	return nil, notMocked("GetInvitationsByTarget")
+}
+
+func (m *MockClient) GetInvitationsByTargetContext(ctx context.Context, targetType, targetValue string, opts ...vortex.ListOption) ([]vortex.InvitationResult, error) {
+	m.record("GetInvitationsByTargetContext", targetType, targetValue, opts)
+	if m.GetInvitationsByTargetContextFunc != nil {
+		return m.GetInvitationsByTargetContextFunc(ctx, targetType, targetValue, opts...)
+	}
+	return nil, notMocked("GetInvitationsByTargetContext")
+}
+
+func (m *MockClient) GetInvitationsForTarget(ctx context.Context, target vortex.InvitationTarget, opts ...vortex.ListOption) ([]vortex.InvitationResult, error) {
+	m.record("GetInvitationsForTarget", target, opts)
+	if m.GetInvitationsForTargetFunc != nil {
+		return m.GetInvitationsForTargetFunc(ctx, target, opts...)
+	}
+	return nil, notMocked("GetInvitationsForTarget")
+}
+
+func (m *MockClient) GetLandingPage(ctx context.Context, widgetConfigurationID string, opts ...vortex.RequestOption) (*vortex.LandingPage, error) {
+	m.record("GetLandingPage", widgetConfigurationID, opts)
+	if m.GetLandingPageFunc != nil {
+		return m.GetLandingPageFunc(ctx, widgetConfigurationID, opts...)
+	}
+	return nil, notMocked("GetLandingPage")
+}
+
+func (m *MockClient) GetSMSSettings(ctx context.Context, opts ...vortex.RequestOption) (*vortex.SMSSettings, error) {
+	m.record("GetSMSSettings", opts)
+	if m.GetSMSSettingsFunc != nil {
+		return m.GetSMSSettingsFunc(ctx, opts...)
+	}
+	return nil, notMocked("GetSMSSettings")
+}
+
+func (m *MockClient) GetSeatUsage(ctx context.Context, group vortex.GroupRef, opts ...vortex.RequestOption) (*vortex.SeatUsage, error) {
+	m.record("GetSeatUsage", group, opts)
+	if m.GetSeatUsageFunc != nil {
+		return m.GetSeatUsageFunc(ctx, group, opts...)
+	}
+	return nil, notMocked("GetSeatUsage")
+}
+
+func (m *MockClient) GetThrottleSettings(ctx context.Context, opts ...vortex.RequestOption) (*vortex.ThrottleSettings, error) {
+	m.record("GetThrottleSettings", opts)
+	if m.GetThrottleSettingsFunc != nil {
+		return m.GetThrottleSettingsFunc(ctx, opts...)
+	}
+	return nil, notMocked("GetThrottleSettings")
+}
+
+func (m *MockClient) GetThrottleState(ctx context.Context, inviterID string, opts ...vortex.RequestOption) (*vortex.ThrottleState, error) {
+	m.record("GetThrottleState", inviterID, opts)
+	if m.GetThrottleStateFunc != nil {
+		return m.GetThrottleStateFunc(ctx, inviterID, opts...)
+	}
+	return nil, notMocked("GetThrottleState")
+}
+
+func (m *MockClient) GetVariantMetrics(ctx context.Context, widgetConfigurationID string, opts ...vortex.RequestOption) ([]vortex.VariantMetrics, error) {
+	m.record("GetVariantMetrics", widgetConfigurationID, opts)
+	if m.GetVariantMetricsFunc != nil {
+		return m.GetVariantMetricsFunc(ctx, widgetConfigurationID, opts...)
+	}
+	return nil, notMocked("GetVariantMetrics")
+}
+
+func (m *MockClient) GetWidgetBootstrap(ctx context.Context, user *vortex.User, group vortex.GroupRef, opts ...vortex.RequestOption) (*vortex.WidgetBootstrap, error) {
+	m.record("GetWidgetBootstrap", user, group, opts)
+	if m.GetWidgetBootstrapFunc != nil {
+		return m.GetWidgetBootstrapFunc(ctx, user, group, opts...)
+	}
+	return nil, notMocked("GetWidgetBootstrap")
+}
+
+func (m *MockClient) GraphQL(ctx context.Context, query string, vars map[string]interface{}, out interface{}, opts ...vortex.RequestOption) error {
+	m.record("GraphQL", query, vars, out, opts)
+	if m.GraphQLFunc != nil {
+		return m.GraphQLFunc(ctx, query, vars, out, opts...)
+	}
+	return notMocked("GraphQL")
+}
+
+// LastRateLimit returns nil when LastRateLimitFunc is not set, like a client
+// that has not seen any rate limit headers
+func (m *MockClient) LastRateLimit() *vortex.RateLimit {
+	m.record("LastRateLimit")
+	if m.LastRateLimitFunc != nil {
+		return m.LastRateLimitFunc()
+	}
+	return nil
+}
+
+// LastResponse returns nil when LastResponseFunc is not set, like a client
+// that has not received a response yet
+func (m *MockClient) LastResponse() *vortex.ResponseMetadata {
+	m.record("LastResponse")
+	if m.LastResponseFunc != nil {
+		return m.LastResponseFunc()
+	}
+	return nil
+}
+
+func (m *MockClient) ListGroupInviteLinks(ctx context.Context, group vortex.GroupRef, opts ...vortex.RequestOption) ([]vortex.GroupInviteLink, error) {
+	m.record("ListGroupInviteLinks", group, opts)
+	if m.ListGroupInviteLinksFunc != nil {
+		return m.ListGroupInviteLinksFunc(ctx, group, opts...)
+	}
+	return nil, notMocked("ListGroupInviteLinks")
+}
+
+func (m *MockClient) ListGroupMembers(ctx context.Context, groupType, groupID string, opts ...vortex.RequestOption) *vortex.MemberIterator {
+	m.record("ListGroupMembers", groupType, groupID, opts)
+	if m.ListGroupMembersFunc != nil {
+		return m.ListGroupMembersFunc(ctx, groupType, groupID, opts...)
+	}
+	return vortex.NewMemberIterator(ctx, func(ctx context.Context, cursor string) ([]vortex.Member, string, error) {
+		return nil, "", notMocked("ListGroupMembers")
+	})
+}
+
+func (m *MockClient) ListInvitations(ctx context.Context, opts vortex.ListOptions) *vortex.InvitationIterator {
+	m.record("ListInvitations", opts)
+	if m.ListInvitationsFunc != nil {
+		return m.ListInvitationsFunc(ctx, opts)
+	}
+	return vortex.NewInvitationIterator(ctx, func(ctx context.Context, cursor string) ([]vortex.InvitationResult, string, error) {
+		return nil, "", notMocked("ListInvitations")
+	})
+}
+
+func (m *MockClient) ListReminders(ctx context.Context, invitationID string, opts ...vortex.RequestOption) ([]vortex.Reminder, error) {
+	m.record("ListReminders", invitationID, opts)
+	if m.ListRemindersFunc != nil {
+		return m.ListRemindersFunc(ctx, invitationID, opts...)
+	}
+	return nil, notMocked("ListReminders")
+}
+
+func (m *MockClient) ListTemplateLocalizations(ctx context.Context, templateID string, opts ...vortex.RequestOption) ([]vortex.TemplateLocalization, error) {
+	m.record("ListTemplateLocalizations", templateID, opts)
+	if m.ListTemplateLocalizationsFunc != nil {
+		return m.ListTemplateLocalizationsFunc(ctx, templateID, opts...)
+	}
+	return nil, notMocked("ListTemplateLocalizations")
+}
+
+func (m *MockClient) MoveInvitation(ctx context.Context, invitationID string, fromGroup, toGroup vortex.GroupRef, opts ...vortex.RequestOption) (*vortex.InvitationResult, error) {
+	m.record("MoveInvitation", invitationID, fromGroup, toGroup, opts)
+	if m.MoveInvitationFunc != nil {
+		return m.MoveInvitationFunc(ctx, invitationID, fromGroup, toGroup, opts...)
+	}
+	return nil, notMocked("MoveInvitation")
+}
+
+func (m *MockClient) PatchInvitationAttributes(ctx context.Context, invitationID string, attrs map[string]interface{}, opts ...vortex.RequestOption) (*vortex.InvitationResult, error) {
+	m.record("PatchInvitationAttributes", invitationID, attrs, opts)
+	if m.PatchInvitationAttributesFunc != nil {
+		return m.PatchInvitationAttributesFunc(ctx, invitationID, attrs, opts...)
+	}
+	return nil, notMocked("PatchInvitationAttributes")
+}
+
+func (m *MockClient) PreviewInvitationEmail(ctx context.Context, templateID string, sampleData map[string]interface{}, opts ...vortex.RequestOption) (*vortex.EmailPreview, error) {
+	m.record("PreviewInvitationEmail", templateID, sampleData, opts)
+	if m.PreviewInvitationEmailFunc != nil {
+		return m.PreviewInvitationEmailFunc(ctx, templateID, sampleData, opts...)
+	}
+	return nil, notMocked("PreviewInvitationEmail")
+}
+
+func (m *MockClient) Reinvite(invitationID string, opts ...vortex.RequestOption) (*vortex.InvitationResult, error) {
+	m.record("Reinvite", invitationID, opts)
+	if m.ReinviteFunc != nil {
+		return m.ReinviteFunc(invitationID, opts...)
+	}
+	return nil, notMocked("Reinvite")
+}
+
+func (m *MockClient) ReinviteContext(ctx context.Context, invitationID string, opts ...vortex.RequestOption) (*vortex.InvitationResult, error) {
+	m.record("ReinviteContext", invitationID, opts)
+	if m.ReinviteContextFunc != nil {
+		return m.ReinviteContextFunc(ctx, invitationID, opts...)
+	}
+	return nil, notMocked("ReinviteContext")
+}
+
+func (m *MockClient) ReinviteMany(ctx context.Context, invitationIDs []string, opts ...vortex.RequestOption) (*vortex.BulkResult, error) {
+	m.record("ReinviteMany", invitationIDs, opts)
+	if m.ReinviteManyFunc != nil {
+		return m.ReinviteManyFunc(ctx, invitationIDs, opts...)
+	}
+	return nil, notMocked("ReinviteMany")
+}
+
+func (m *MockClient) RejectInvitation(ctx context.Context, invitationID string, reviewer vortex.Actor, opts ...vortex.RequestOption) (*vortex.InvitationResult, error) {
+	m.record("RejectInvitation", invitationID, reviewer, opts)
+	if m.RejectInvitationFunc != nil {
+		return m.RejectInvitationFunc(ctx, invitationID, reviewer, opts...)
+	}
+	return nil, notMocked("RejectInvitation")
+}
+
+func (m *MockClient) RemoveGroupMember(ctx context.Context, groupType, groupID, userID string, opts ...vortex.RequestOption) error {
+	m.record("RemoveGroupMember", groupType, groupID, userID, opts)
+	if m.RemoveGroupMemberFunc != nil {
+		return m.RemoveGroupMemberFunc(ctx, groupType, groupID, userID, opts...)
+	}
+	return notMocked("RemoveGroupMember")
+}
+
+func (m *MockClient) RemoveInvitationTags(ctx context.Context, invitationID string, tags ...string) (*vortex.InvitationResult, error) {
+	m.record("RemoveInvitationTags", invitationID, tags)
+	if m.RemoveInvitationTagsFunc != nil {
+		return m.RemoveInvitationTagsFunc(ctx, invitationID, tags...)
+	}
+	return nil, notMocked("RemoveInvitationTags")
+}
+
+func (m *MockClient) RemoveSuppression(ctx context.Context, target vortex.InvitationTarget, opts ...vortex.RequestOption) error {
+	m.record("RemoveSuppression", target, opts)
+	if m.RemoveSuppressionFunc != nil {
+		return m.RemoveSuppressionFunc(ctx, target, opts...)
+	}
+	return notMocked("RemoveSuppression")
+}
+
+func (m *MockClient) RestoreInvitation(ctx context.Context, invitationID string, opts ...vortex.RequestOption) (*vortex.InvitationResult, error) {
+	m.record("RestoreInvitation", invitationID, opts)
+	if m.RestoreInvitationFunc != nil {
+		return m.RestoreInvitationFunc(ctx, invitationID, opts...)
+	}
+	return nil, notMocked("RestoreInvitation")
+}
+
+func (m *MockClient) RetryDelivery(ctx context.Context, invitationID, deliveryID string, opts ...vortex.RequestOption) (*vortex.Delivery, error) {
+	m.record("RetryDelivery", invitationID, deliveryID, opts)
+	if m.RetryDeliveryFunc != nil {
+		return m.RetryDeliveryFunc(ctx, invitationID, deliveryID, opts...)
+	}
+	return nil, notMocked("RetryDelivery")
+}
+
+func (m *MockClient) RevokeAllForTarget(ctx context.Context, target vortex.InvitationTarget, opts ...vortex.RequestOption) ([]string, error) {
+	m.record("RevokeAllForTarget", target, opts)
+	if m.RevokeAllForTargetFunc != nil {
+		return m.RevokeAllForTargetFunc(ctx, target, opts...)
+	}
+	return nil, notMocked("RevokeAllForTarget")
+}
+
+func (m *MockClient) RevokeGroupInviteLink(ctx context.Context, linkID string, opts ...vortex.RequestOption) error {
+	m.record("RevokeGroupInviteLink", linkID, opts)
+	if m.RevokeGroupInviteLinkFunc != nil {
+		return m.RevokeGroupInviteLinkFunc(ctx, linkID, opts...)
+	}
+	return notMocked("RevokeGroupInviteLink")
+}
+
+func (m *MockClient) RevokeInvitation(invitationID string, opts ...vortex.RequestOption) error {
+	m.record("RevokeInvitation", invitationID, opts)
+	if m.RevokeInvitationFunc != nil {
+		return m.RevokeInvitationFunc(invitationID, opts...)
+	}
+	return notMocked("RevokeInvitation")
+}
+
+func (m *MockClient) RevokeInvitationContext(ctx context.Context, invitationID string, opts ...vortex.RequestOption) error {
+	m.record("RevokeInvitationContext", invitationID, opts)
+	if m.RevokeInvitationContextFunc != nil {
+		return m.RevokeInvitationContextFunc(ctx, invitationID, opts...)
+	}
+	return notMocked("RevokeInvitationContext")
+}
+
+func (m *MockClient) RevokeInvitations(ctx context.Context, invitationIDs []string, opts ...vortex.RequestOption) (*vortex.BulkResult, error) {
+	m.record("RevokeInvitations", invitationIDs, opts)
+	if m.RevokeInvitationsFunc != nil {
+		return m.RevokeInvitationsFunc(ctx, invitationIDs, opts...)
+	}
+	return nil, notMocked("RevokeInvitations")
+}
+
+func (m *MockClient) RotateGroupInviteLink(ctx context.Context, linkID string, opts ...vortex.RequestOption) (*vortex.GroupInviteLink, error) {
+	m.record("RotateGroupInviteLink", linkID, opts)
+	if m.RotateGroupInviteLinkFunc != nil {
+		return m.RotateGroupInviteLinkFunc(ctx, linkID, opts...)
+	}
+	return nil, notMocked("RotateGroupInviteLink")
+}
+
+func (m *MockClient) ScheduleReminders(ctx context.Context, invitationID string, delays ...time.Duration) ([]vortex.Reminder, error) {
+	m.record("ScheduleReminders", invitationID, delays)
+	if m.ScheduleRemindersFunc != nil {
+		return m.ScheduleRemindersFunc(ctx, invitationID, delays...)
+	}
+	return nil, notMocked("ScheduleReminders")
+}
+
+func (m *MockClient) SetGroupPolicy(ctx context.Context, group vortex.GroupRef, policy vortex.GroupPolicy, opts ...vortex.RequestOption) (*vortex.GroupPolicy, error) {
+	m.record("SetGroupPolicy", group, policy, opts)
+	if m.SetGroupPolicyFunc != nil {
+		return m.SetGroupPolicyFunc(ctx, group, policy, opts...)
+	}
+	return nil, notMocked("SetGroupPolicy")
+}
+
+func (m *MockClient) SetReferralRewardState(ctx context.Context, invitationID, state string, opts ...vortex.RequestOption) (*vortex.InvitationResult, error) {
+	m.record("SetReferralRewardState", invitationID, state, opts)
+	if m.SetReferralRewardStateFunc != nil {
+		return m.SetReferralRewardStateFunc(ctx, invitationID, state, opts...)
+	}
+	return nil, notMocked("SetReferralRewardState")
+}
+
+func (m *MockClient) ShortenLink(ctx context.Context, longURL string, opts ...vortex.RequestOption) (string, error) {
+	m.record("ShortenLink", longURL, opts)
+	if m.ShortenLinkFunc != nil {
+		return m.ShortenLinkFunc(ctx, longURL, opts...)
+	}
+	return "", notMocked("ShortenLink")
+}
+
+func (m *MockClient) StreamEvents(ctx context.Context, opts vortex.StreamOptions) (<-chan vortex.Event, error) {
+	m.record("StreamEvents", opts)
+	if m.StreamEventsFunc != nil {
+		return m.StreamEventsFunc(ctx, opts)
+	}
+	return nil, notMocked("StreamEvents")
+}
+
+func (m *MockClient) SuppressHardBounce(ctx context.Context, event vortex.DeliveryBouncedEvent, opts ...vortex.RequestOption) (bool, error) {
+	m.record("SuppressHardBounce", event, opts)
+	if m.SuppressHardBounceFunc != nil {
+		return m.SuppressHardBounceFunc(ctx, event, opts...)
+	}
+	return false, notMocked("SuppressHardBounce")
+}
+
+func (m *MockClient) SuppressTarget(ctx context.Context, target vortex.InvitationTarget, reason string, opts ...vortex.RequestOption) error {
+	m.record("SuppressTarget", target, reason, opts)
+	if m.SuppressTargetFunc != nil {
+		return m.SuppressTargetFunc(ctx, target, reason, opts...)
+	}
+	return notMocked("SuppressTarget")
+}
+
+func (m *MockClient) UpdateLandingPage(ctx context.Context, widgetConfigurationID string, page vortex.LandingPage, opts ...vortex.RequestOption) (*vortex.LandingPage, error) {
+	m.record("UpdateLandingPage", widgetConfigurationID, page, opts)
+	if m.UpdateLandingPageFunc != nil {
+		return m.UpdateLandingPageFunc(ctx, widgetConfigurationID, page, opts...)
+	}
+	return nil, notMocked("UpdateLandingPage")
+}
+
+func (m *MockClient) UpdateSMSSettings(ctx context.Context, settings vortex.SMSSettings, opts ...vortex.RequestOption) (*vortex.SMSSettings, error) {
+	m.record("UpdateSMSSettings", settings, opts)
+	if m.UpdateSMSSettingsFunc != nil {
+		return m.UpdateSMSSettingsFunc(ctx, settings, opts...)
+	}
+	return nil, notMocked("UpdateSMSSettings")
+}
+
+func (m *MockClient) UpdateThrottleSettings(ctx context.Context, settings vortex.ThrottleSettings, opts ...vortex.RequestOption) (*vortex.ThrottleSettings, error) {
+	m.record("UpdateThrottleSettings", settings, opts)
+	if m.UpdateThrottleSettingsFunc != nil {
+		return m.UpdateThrottleSettingsFunc(ctx, settings, opts...)
+	}
+	return nil, notMocked("UpdateThrottleSettings")
+}
+
+// Use does nothing when UseFunc is not set, since the mock sends no requests
+func (m *MockClient) Use(middleware ...vortex.Middleware) {
+	m.record("Use", middleware)
+	if m.UseFunc != nil {
+		m.UseFunc(middleware...)
+	}
+}
+
+func (m *MockClient) VerifyJWT(token string) (*vortex.JWTClaims, error) {
+	m.record("VerifyJWT", token)
+	if m.VerifyJWTFunc != nil {
+		return m.VerifyJWTFunc(token)
+	}
+	return nil, notMocked("VerifyJWT")
+}
@@ -0,0 +1,74 @@
+package vortextest
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	vortex "github.com/TeamVortexSoftware/vortex-go-sdk"
+)
+
+// revokePending is the kind of application code the mock stands in for
+func revokePending(ctx context.Context, client vortex.VortexClient, targetType, targetValue string) (int, error) {
+	invitations, err := client.GetInvitationsByTargetContext(ctx, targetType, targetValue)
+	if err != nil {
+		return 0, err
+	}
+	revoked := 0
+	for _, invitation := range invitations {
+		if invitation.Status != "pending" {
+			continue
+		}
+		if err := client.RevokeInvitationContext(ctx, invitation.ID); err != nil {
+			return revoked, err
+		}
+		revoked++
+	}
+	return revoked, nil
+}
+
+func TestMockClient(t *testing.T) {
+	mock := &MockClient{
+		GetInvitationsByTargetContextFunc: func(ctx context.Context, targetType, targetValue string, opts ...vortex.ListOption) ([]vortex.InvitationResult, error) {
+			return []vortex.InvitationResult{
+				{ID: "inv-1", Status: "pending"},
+				{ID: "inv-2", Status: "accepted"},
+			}, nil
+		},
+		RevokeInvitationContextFunc: func(ctx context.Context, invitationID string, opts ...vortex.RequestOption) error {
+			return nil
+		},
+	}
+
+	revoked, err := revokePending(context.Background(), mock, "email", "user@example.com")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if revoked != 1 {
+		t.Errorf("Expected 1 revoked invitation, got %d", revoked)
+	}
+
+	calls := mock.CallsTo("RevokeInvitationContext")
+	if len(calls) != 1 || calls[0].Args[0] != "inv-1" {
+		t.Errorf("Expected inv-1 to be revoked, got %+v", calls)
+	}
+	if len(mock.Calls()) != 2 {
+		t.Errorf("Expected 2 calls, got %d", len(mock.Calls()))
+	}
+
+	mock.Reset()
+	if len(mock.Calls()) != 0 {
+		t.Error("Expected Reset to forget calls")
+	}
+}
+
+func TestMockClient_NotMocked(t *testing.T) {
+	mock := &MockClient{}
+
+	if _, err := mock.GetInvitation("inv-1"); !errors.Is(err, ErrNotMocked) {
+		t.Errorf("Expected ErrNotMocked, got %v", err)
+	}
+	if err := mock.RevokeInvitation("inv-1"); !errors.Is(err, ErrNotMocked) {
+		t.Errorf("Expected ErrNotMocked, got %v", err)
+	}
+}
@@ -0,0 +1,434 @@
+package vortextest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	vortex "github.com/TeamVortexSoftware/vortex-go-sdk"
+)
+
+// Server is an in-memory fake of the Vortex API for integration tests that
+// run offline. It keeps invitations in memory and supports creating, getting,
+// listing by target or group, accepting, reinviting and revoking them; other
+// endpoints respond with 501 Not Implemented.
+//
+//	server := vortextest.NewServer()
+//	defer server.Close()
+//	client := server.Client()
+//
+//	invitation, _ := client.CreateInvitation(ctx, vortex.CreateInvitationRequest{...})
+//	_, err := client.AcceptInvitations([]string{invitation.ID}, target)
+//	// server.Invitation(invitation.ID).Status == vortex.StatusAccepted
+type Server struct {
+	*httptest.Server
+
+	// Now returns the time stamped on invitations; it defaults to time.Now
+	Now func() time.Time
+
+	mu          sync.Mutex
+	invitations map[string]*vortex.InvitationResult
+	order       []string
+	idempotent  map[string]string
+	lastID      int
+}
+
+// NewServer starts a fake API with no invitations; call Close when done
+func NewServer() *Server {
+	s := &Server{
+		Now:         time.Now,
+		invitations: make(map[string]*vortex.InvitationResult),
+		idempotent:  make(map[string]string),
+	}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	return s
+}
+
+// Client returns a client that talks to the server
+func (s *Server) Client(opts ...vortex.ClientOption) *vortex.Client {
+	return vortex.NewClientWithOptions("test-api-key", s.URL, s.Server.Client(), opts...)
+}
+
+// AddInvitation stores invitation as if it had been created, filling in an ID,
+// status and creation time if they are unset, and returns the stored copy
+func (s *Server) AddInvitation(invitation vortex.InvitationResult) vortex.InvitationResult {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return *s.add(&invitation)
+}
+
+// Invitation returns a copy of the stored invitation, or nil if there is none
+// with the ID
+func (s *Server) Invitation(invitationID string) *vortex.InvitationResult {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	invitation, ok := s.invitations[invitationID]
+	if !ok {
+		return nil
+	}
+	stored := *invitation
+	return &stored
+}
+
+// Invitations returns copies of every stored invitation in creation order
+func (s *Server) Invitations() []vortex.InvitationResult {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	invitations := make([]vortex.InvitationResult, 0, len(s.order))
+	for _, id := range s.order {
+		if invitation, ok := s.invitations[id]; ok {
+			invitations = append(invitations, *invitation)
+		}
+	}
+	return invitations
+}
+
+// add stores invitation; s.mu must be held
+func (s *Server) add(invitation *vortex.InvitationResult) *vortex.InvitationResult {
+	if invitation.ID == "" {
+		s.lastID++
+		invitation.ID = fmt.Sprintf("inv-%d", s.lastID)
+	}
+	if invitation.Status == "" {
+		invitation.Status = vortex.StatusPending
+	}
+	if invitation.CreatedAt.IsZero() {
+		invitation.CreatedAt = s.now()
+	}
+	if invitation.Version == "" {
+		invitation.Version = "1"
+	}
+	if _, ok := s.invitations[invitation.ID]; !ok {
+		s.order = append(s.order, invitation.ID)
+	}
+	s.invitations[invitation.ID] = invitation
+	return invitation
+}
+
+func (s *Server) now() time.Time {
+	return s.Now().UTC()
+}
+
+// touch records a change to invitation; s.mu must be held
+func (s *Server) touch(invitation *vortex.InvitationResult) {
+	modified := s.now()
+	invitation.ModifiedAt = &modified
+	version, _ := strconv.Atoi(invitation.Version)
+	invitation.Version = strconv.Itoa(version + 1)
+}
+
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Header.Get("x-api-key") == "" {
+		writeError(w, http.StatusUnauthorized, "missing API key")
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	path := strings.TrimPrefix(r.URL.Path, "/api/v1/invitations")
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+	switch {
+	case path == r.URL.Path:
+		// Not an invitation endpoint
+	case path == "" && r.Method == http.MethodPost:
+		s.create(w, r)
+		return
+	case path == "" && r.Method == http.MethodGet:
+		s.list(w, r, func(invitation *vortex.InvitationResult) bool {
+			return hasTarget(invitation, r.URL.Query().Get("targetType"), r.URL.Query().Get("targetValue"))
+		})
+		return
+	case path == "/accept" && r.Method == http.MethodPost:
+		s.accept(w, r)
+		return
+	case len(segments) == 3 && segments[0] == "by-group":
+		inGroup := func(invitation *vortex.InvitationResult) bool {
+			return hasGroup(invitation, segments[1], segments[2])
+		}
+		switch r.Method {
+		case http.MethodGet:
+			s.list(w, r, inGroup)
+			return
+		case http.MethodDelete:
+			s.deleteWhere(inGroup)
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+	case len(segments) == 1 && r.Method == http.MethodGet:
+		if invitation := s.find(w, segments[0]); invitation != nil {
+			writeJSON(w, http.StatusOK, invitation)
+		}
+		return
+	case len(segments) == 1 && r.Method == http.MethodDelete:
+		if invitation := s.find(w, segments[0]); invitation != nil {
+			invitation.Status = vortex.StatusRevoked
+			invitation.Deactivated = true
+			s.touch(invitation)
+			w.WriteHeader(http.StatusNoContent)
+		}
+		return
+	case len(segments) == 2 && segments[1] == "reinvite" && r.Method == http.MethodPost:
+		s.reinvite(w, segments[0])
+		return
+	}
+
+	writeError(w, http.StatusNotImplemented, fmt.Sprintf("vortextest: %s %s is not supported by the fake server", r.Method, r.URL.Path))
+}
+
+func (s *Server) create(w http.ResponseWriter, r *http.Request) {
+	key := r.Header.Get("Idempotency-Key")
+	if id, ok := s.idempotent[key]; ok && key != "" {
+		writeJSON(w, http.StatusOK, s.invitations[id])
+		return
+	}
+
+	var req vortex.CreateInvitationRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid JSON: "+err.Error())
+		return
+	}
+	fields := make(map[string][]string)
+	if req.WidgetConfigurationID == "" {
+		fields["widgetConfigurationId"] = []string{"is required"}
+	}
+	if len(req.Targets) == 0 {
+		fields["targets"] = []string{"must not be empty"}
+	}
+	for i, target := range req.Targets {
+		if !target.Type.Valid() {
+			fields[fmt.Sprintf("targets[%d].type", i)] = []string{fmt.Sprintf("unknown target type %q", target.Type)}
+		}
+	}
+	if len(fields) > 0 {
+		writeJSON(w, http.StatusUnprocessableEntity, map[string]interface{}{"errors": fields})
+		return
+	}
+
+	groups := make([]vortex.InvitationGroup, len(req.Groups))
+	for i, group := range req.Groups {
+		groups[i] = vortex.InvitationGroup{
+			ID:        fmt.Sprintf("group-%s-%s", group.Type, group.GroupID),
+			GroupID:   group.GroupID,
+			Type:      group.Type,
+			CreatedAt: s.now(),
+		}
+	}
+	deliveryTypes := req.DeliveryTypes
+	if len(deliveryTypes) == 0 {
+		deliveryTypes = []vortex.DeliveryType{vortex.DeliveryEmail}
+	}
+
+	invitation := s.add(&vortex.InvitationResult{
+		Attributes:            req.Attributes,
+		DeliveryCount:         1,
+		DeliveryTypes:         deliveryTypes,
+		ForeignCreatorID:      req.InviterID,
+		Target:                req.Targets,
+		WidgetConfigurationID: req.WidgetConfigurationID,
+		Groups:                groups,
+		Expires:               req.Expires,
+		DeepLink:              req.DeepLink,
+		VariantID:             req.VariantID,
+		Referral:              req.Referral,
+		Locale:                req.Locale,
+	})
+	if key != "" {
+		s.idempotent[key] = invitation.ID
+	}
+	writeJSON(w, http.StatusCreated, invitation)
+}
+
+func (s *Server) list(w http.ResponseWriter, r *http.Request, match func(*vortex.InvitationResult) bool) {
+	query := r.URL.Query()
+
+	var matched []*vortex.InvitationResult
+	for _, id := range s.order {
+		invitation, ok := s.invitations[id]
+		if ok && match(invitation) && matchesFilters(invitation, query) {
+			matched = append(matched, invitation)
+		}
+	}
+
+	start, _ := strconv.Atoi(query.Get("cursor"))
+	if start > len(matched) {
+		start = len(matched)
+	}
+	end := len(matched)
+	if limit, err := strconv.Atoi(query.Get("limit")); err == nil && limit > 0 && start+limit < end {
+		end = start + limit
+	}
+
+	response := vortex.InvitationsResponse{Invitations: make([]vortex.InvitationResult, 0, end-start)}
+	for _, invitation := range matched[start:end] {
+		response.Invitations = append(response.Invitations, *invitation)
+	}
+	if end < len(matched) {
+		response.NextCursor = strconv.Itoa(end)
+		response.HasMore = true
+	}
+	writeJSON(w, http.StatusOK, response)
+}
+
+func (s *Server) accept(w http.ResponseWriter, r *http.Request) {
+	var req vortex.AcceptInvitationRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid JSON: "+err.Error())
+		return
+	}
+	if len(req.InvitationIDs) == 0 {
+		writeJSON(w, http.StatusUnprocessableEntity, map[string]interface{}{
+			"errors": map[string][]string{"invitationIds": {"must not be empty"}},
+		})
+		return
+	}
+
+	// Check every invitation before accepting any
+	invitations := make([]*vortex.InvitationResult, len(req.InvitationIDs))
+	for i, id := range req.InvitationIDs {
+		invitation := s.find(w, id)
+		if invitation == nil {
+			return
+		}
+		if !s.acceptable(invitation) {
+			writeError(w, http.StatusConflict, fmt.Sprintf("invitation %s is %s", id, invitation.Status))
+			return
+		}
+		invitations[i] = invitation
+	}
+
+	for _, invitation := range invitations {
+		invitation.Status = vortex.StatusAccepted
+		invitation.Accepts = append(invitation.Accepts, vortex.InvitationAcceptance{
+			ID:         fmt.Sprintf("accept-%s-%d", invitation.ID, len(invitation.Accepts)+1),
+			AccountID:  invitation.AccountID,
+			ProjectID:  invitation.ProjectID,
+			AcceptedAt: s.now(),
+			Target:     req.Target,
+		})
+		s.touch(invitation)
+	}
+	writeJSON(w, http.StatusOK, invitations[len(invitations)-1])
+}
+
+func (s *Server) reinvite(w http.ResponseWriter, invitationID string) {
+	invitation := s.find(w, invitationID)
+	if invitation == nil {
+		return
+	}
+	if !s.acceptable(invitation) {
+		writeError(w, http.StatusConflict, fmt.Sprintf("invitation %s is %s", invitationID, invitation.Status))
+		return
+	}
+	invitation.DeliveryCount++
+	s.touch(invitation)
+	writeJSON(w, http.StatusOK, invitation)
+}
+
+func (s *Server) deleteWhere(match func(*vortex.InvitationResult) bool) {
+	order := s.order[:0]
+	for _, id := range s.order {
+		if match(s.invitations[id]) {
+			delete(s.invitations, id)
+			continue
+		}
+		order = append(order, id)
+	}
+	s.order = order
+}
+
+// find returns the invitation, writing a 404 if it does not exist
+func (s *Server) find(w http.ResponseWriter, invitationID string) *vortex.InvitationResult {
+	invitation, ok := s.invitations[invitationID]
+	if !ok {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("invitation %s not found", invitationID))
+		return nil
+	}
+	return invitation
+}
+
+// acceptable reports whether an invitation can still be accepted or resent
+func (s *Server) acceptable(invitation *vortex.InvitationResult) bool {
+	if invitation.Deactivated || invitation.Expired {
+		return false
+	}
+	if invitation.Expires != nil && invitation.Expires.Before(s.now()) {
+		return false
+	}
+	switch invitation.Status {
+	case vortex.StatusAccepted, vortex.StatusRevoked, vortex.StatusExpired, vortex.StatusRejected, vortex.StatusPendingApproval:
+		return false
+	}
+	return true
+}
+
+// matchesFilters applies the filters of vortex.ListOptions
+func matchesFilters(invitation *vortex.InvitationResult, query url.Values) bool {
+	if invitation.Archived && query.Get("includeArchived") != "true" {
+		return false
+	}
+	if statuses := query.Get("status"); statuses != "" {
+		found := false
+		for _, status := range strings.Split(statuses, ",") {
+			found = found || string(invitation.Status) == status
+		}
+		if !found {
+			return false
+		}
+	}
+	if invitationType := query.Get("invitationType"); invitationType != "" && invitation.InvitationType != invitationType {
+		return false
+	}
+	if after, err := time.Parse(time.RFC3339, query.Get("createdAfter")); err == nil && !invitation.CreatedAt.After(after) {
+		return false
+	}
+	if before, err := time.Parse(time.RFC3339, query.Get("createdBefore")); err == nil && !invitation.CreatedAt.Before(before) {
+		return false
+	}
+	if deactivated := query.Get("deactivated"); deactivated != "" && strconv.FormatBool(invitation.Deactivated) != deactivated {
+		return false
+	}
+	if expired := query.Get("expired"); expired != "" && strconv.FormatBool(invitation.Expired) != expired {
+		return false
+	}
+	return true
+}
+
+// hasTarget matches every invitation when targetType is empty, and every
+// target of the type when targetValue is
+func hasTarget(invitation *vortex.InvitationResult, targetType, targetValue string) bool {
+	if targetType == "" {
+		return true
+	}
+	for _, target := range invitation.Target {
+		if string(target.Type) == targetType && (targetValue == "" || strings.EqualFold(target.Value, targetValue)) {
+			return true
+		}
+	}
+	return false
+}
+
+func hasGroup(invitation *vortex.InvitationResult, groupType, groupID string) bool {
+	for _, group := range invitation.Groups {
+		if group.Type == groupType && group.GroupID == groupID {
+			return true
+		}
+	}
+	return false
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
+
+func writeError(w http.ResponseWriter, status int, message string) {
+	writeJSON(w, status, map[string]string{"error": message})
+}
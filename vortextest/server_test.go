@@ -0,0 +1,161 @@
+package vortextest
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	vortex "github.com/TeamVortexSoftware/vortex-go-sdk"
+)
+
+func TestServer(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+	client := server.Client()
+	ctx := context.Background()
+
+	target := vortex.InvitationTarget{Type: vortex.TargetEmail, Value: "user@example.com"}
+	created, err := client.CreateInvitation(ctx, vortex.CreateInvitationRequest{
+		WidgetConfigurationID: "wc-1",
+		Targets:               []vortex.InvitationTarget{target},
+		Groups:                []vortex.GroupRef{{Type: "team", GroupID: "team-1"}},
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if created.ID == "" || created.Status != vortex.StatusPending || created.CreatedAt.IsZero() {
+		t.Errorf("Expected a pending invitation, got %+v", created)
+	}
+
+	invitations, err := client.GetInvitationsByTarget("email", "user@example.com")
+	if err != nil || len(invitations) != 1 || invitations[0].ID != created.ID {
+		t.Fatalf("Expected the invitation by target, got %+v, %v", invitations, err)
+	}
+	invitations, err = client.GetInvitationsByGroup("team", "team-1")
+	if err != nil || len(invitations) != 1 {
+		t.Fatalf("Expected the invitation by group, got %+v, %v", invitations, err)
+	}
+
+	accepted, err := client.AcceptInvitations([]string{created.ID}, target)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if accepted.Status != vortex.StatusAccepted || len(accepted.Accepts) != 1 {
+		t.Errorf("Expected an accepted invitation, got %+v", accepted)
+	}
+	if stored := server.Invitation(created.ID); stored.Status != vortex.StatusAccepted {
+		t.Errorf("Expected the stored invitation to be accepted, got %s", stored.Status)
+	}
+
+	if _, err := client.AcceptInvitations([]string{created.ID}, target); !errors.Is(err, vortex.ErrConflict) {
+		t.Errorf("Expected ErrConflict accepting twice, got %v", err)
+	}
+}
+
+func TestServer_Revoke(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+	client := server.Client()
+
+	invitation := server.AddInvitation(vortex.InvitationResult{
+		Target: []vortex.InvitationTarget{{Type: vortex.TargetSMS, Value: "+15555550100"}},
+	})
+
+	if err := client.RevokeInvitation(invitation.ID); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	revoked, err := client.GetInvitation(invitation.ID)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if revoked.Status != vortex.StatusRevoked || revoked.ModifiedAt == nil {
+		t.Errorf("Expected a revoked invitation, got %+v", revoked)
+	}
+	if _, err := client.Reinvite(invitation.ID); !errors.Is(err, vortex.ErrConflict) {
+		t.Errorf("Expected ErrConflict reinviting a revoked invitation, got %v", err)
+	}
+	if _, err := client.GetInvitation("missing"); !errors.Is(err, vortex.ErrNotFound) {
+		t.Errorf("Expected ErrNotFound, got %v", err)
+	}
+}
+
+func TestServer_Pagination(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+	client := server.Client()
+
+	for i := 0; i < 5; i++ {
+		server.AddInvitation(vortex.InvitationResult{
+			Target: []vortex.InvitationTarget{{Type: vortex.TargetEmail, Value: "user@example.com"}},
+		})
+	}
+
+	iter := client.ListInvitations(context.Background(), vortex.ListOptions{
+		TargetType:  vortex.TargetEmail,
+		TargetValue: "user@example.com",
+		PageSize:    2,
+	})
+	count := 0
+	for iter.Next() {
+		count++
+	}
+	if err := iter.Err(); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if count != 5 {
+		t.Errorf("Expected 5 invitations, got %d", count)
+	}
+}
+
+func TestServer_Filters(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+	client := server.Client()
+
+	lastWeek := time.Now().AddDate(0, 0, -7)
+	email := []vortex.InvitationTarget{{Type: vortex.TargetEmail, Value: "user@example.com"}}
+	server.AddInvitation(vortex.InvitationResult{ID: "recent", Target: email})
+	server.AddInvitation(vortex.InvitationResult{ID: "old", Target: email, CreatedAt: lastWeek.AddDate(0, 0, -1)})
+	server.AddInvitation(vortex.InvitationResult{ID: "accepted", Target: email, Status: vortex.StatusAccepted})
+	server.AddInvitation(vortex.InvitationResult{ID: "sms", Target: []vortex.InvitationTarget{{Type: vortex.TargetSMS, Value: "+15555550100"}}})
+
+	iter := client.ListInvitations(context.Background(), vortex.ListOptions{
+		TargetType:   vortex.TargetEmail,
+		Status:       []vortex.InvitationStatus{vortex.StatusPending},
+		CreatedAfter: lastWeek,
+	})
+	var ids []string
+	for iter.Next() {
+		ids = append(ids, iter.Invitation().ID)
+	}
+	if err := iter.Err(); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if len(ids) != 1 || ids[0] != "recent" {
+		t.Errorf("Expected only the recent pending email invitation, got %v", ids)
+	}
+}
+
+func TestServer_Validation(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+
+	_, err := server.Client().CreateInvitation(context.Background(), vortex.CreateInvitationRequest{})
+
+	var validationErr *vortex.ValidationError
+	if !errors.As(err, &validationErr) || len(validationErr.Fields["widgetConfigurationId"]) == 0 {
+		t.Errorf("Expected a validation error for widgetConfigurationId, got %v", err)
+	}
+}
+
+func TestServer_NotImplemented(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+
+	var apiErr *vortex.APIError
+	_, err := server.Client().GetSeatUsage(context.Background(), vortex.GroupRef{Type: "team", GroupID: "team-1"})
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != 501 {
+		t.Errorf("Expected 501 for an unsupported endpoint, got %v", err)
+	}
+}
@@ -0,0 +1,137 @@
+package vortexwebhooks
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// maxPayloadSize bounds how much of a webhook request body is read
+const maxPayloadSize = 1 << 20
+
+// DefaultDedupeTTL is how long Handler remembers event IDs when DedupeTTL is
+// not set; Vortex stops redelivering well before then
+const DefaultDedupeTTL = 72 * time.Hour
+
+// DedupeStore remembers which events have been handled, so redelivered
+// events are skipped
+//
+// vortexredis.WebhookDedupe shares this between instances.
+type DedupeStore interface {
+	// Claim records eventID for ttl and reports whether it was new
+	Claim(ctx context.Context, eventID string, ttl time.Duration) (bool, error)
+	// Release forgets eventID, so a redelivery is handled again
+	Release(ctx context.Context, eventID string) error
+}
+
+// Handler is an http.Handler receiving Vortex webhooks
+//
+// It responds 400 to requests that fail verification, and 500 if Handle
+// returns an error so that Vortex redelivers the event.
+//
+//	http.Handle("/webhooks/vortex", &vortexwebhooks.Handler{
+//	    Secret: os.Getenv("VORTEX_WEBHOOK_SECRET"),
+//	    Dedupe: vortexwebhooks.NewMemoryDedupe(),
+//	    Handle: func(ctx context.Context, event vortexwebhooks.Event) error {
+//	        // ...
+//	    },
+//	})
+type Handler struct {
+	// Secret is the webhook signing secret
+	Secret string
+	// Handle is called with each verified event
+	Handle func(ctx context.Context, event Event) error
+	// Dedupe, if set, skips events that were already handled
+	Dedupe DedupeStore
+	// DedupeTTL defaults to DefaultDedupeTTL
+	DedupeTTL time.Duration
+	// Tolerance defaults to DefaultTolerance
+	Tolerance time.Duration
+}
+
+// ServeHTTP implements http.Handler
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	payload, err := io.ReadAll(io.LimitReader(r.Body, maxPayloadSize))
+	if err != nil {
+		http.Error(w, "failed to read body", http.StatusBadRequest)
+		return
+	}
+
+	tolerance := h.Tolerance
+	if tolerance == 0 {
+		tolerance = DefaultTolerance
+	}
+	event, err := ConstructEventWithTolerance(payload, r.Header.Get(SignatureHeader), h.Secret, tolerance)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	ctx := r.Context()
+	if h.Dedupe != nil && event.ID != "" {
+		ttl := h.DedupeTTL
+		if ttl <= 0 {
+			ttl = DefaultDedupeTTL
+		}
+		isNew, err := h.Dedupe.Claim(ctx, event.ID, ttl)
+		if err != nil {
+			http.Error(w, "failed to check event", http.StatusInternalServerError)
+			return
+		}
+		if !isNew {
+			w.WriteHeader(http.StatusOK)
+			return
+		}
+	}
+
+	if err := h.Handle(ctx, event); err != nil {
+		if h.Dedupe != nil && event.ID != "" {
+			h.Dedupe.Release(ctx, event.ID)
+		}
+		http.Error(w, "failed to handle event", http.StatusInternalServerError)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+// MemoryDedupe is a DedupeStore for a single instance
+type MemoryDedupe struct {
+	mu      sync.Mutex
+	expires map[string]time.Time
+}
+
+var _ DedupeStore = (*MemoryDedupe)(nil)
+
+// NewMemoryDedupe creates an empty MemoryDedupe
+func NewMemoryDedupe() *MemoryDedupe {
+	return &MemoryDedupe{expires: make(map[string]time.Time)}
+}
+
+// Claim implements DedupeStore
+func (d *MemoryDedupe) Claim(ctx context.Context, eventID string, ttl time.Duration) (bool, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	now := time.Now()
+	for id, expires := range d.expires {
+		if !now.Before(expires) {
+			delete(d.expires, id)
+		}
+	}
+
+	if _, ok := d.expires[eventID]; ok {
+		return false, nil
+	}
+	d.expires[eventID] = now.Add(ttl)
+	return true, nil
+}
+
+// Release implements DedupeStore
+func (d *MemoryDedupe) Release(ctx context.Context, eventID string) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	delete(d.expires, eventID)
+	return nil
+}
@@ -0,0 +1,148 @@
+// Package vortexwebhooks verifies Vortex webhook signatures and parses the
+// events into typed payloads.
+//
+// Vortex signs each webhook with HMAC-SHA256 and sends the signature in the
+// X-Vortex-Signature header as "t=<unix time>,v1=<hex signature>", where the
+// signature covers "<unix time>.<request body>". During a secret rotation the
+// header carries one v1 entry per active secret.
+//
+//	event, err := vortexwebhooks.ConstructEvent(body, r.Header.Get(vortexwebhooks.SignatureHeader), secret)
+//	if err != nil {
+//	    http.Error(w, "invalid webhook", http.StatusBadRequest)
+//	    return
+//	}
+//	switch data := event.Data.(type) {
+//	case *vortex.InvitationEvent:
+//	    // invitation.created, .accepted, .revoked or .expired
+//	case *vortex.DeliveryBouncedEvent:
+//	    // ...
+//	}
+//
+// Handler does the same as an http.Handler and can skip redelivered events.
+package vortexwebhooks
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	vortex "github.com/TeamVortexSoftware/vortex-go-sdk"
+)
+
+// SignatureHeader is the request header carrying the webhook signature
+const SignatureHeader = "X-Vortex-Signature"
+
+// DefaultTolerance is how old a signature ConstructEvent accepts, limiting
+// how long a captured webhook can be replayed
+const DefaultTolerance = 5 * time.Minute
+
+var (
+	// ErrInvalidHeader is returned for a missing or malformed signature header
+	ErrInvalidHeader = errors.New("vortexwebhooks: invalid signature header")
+	// ErrInvalidSignature is returned when no signature matches the secret
+	ErrInvalidSignature = errors.New("vortexwebhooks: signature does not match")
+	// ErrTimestampOutOfRange is returned for signatures older than the
+	// tolerance, or too far in the future
+	ErrTimestampOutOfRange = errors.New("vortexwebhooks: signature timestamp out of range")
+)
+
+// Event is a webhook event; see vortex.Event
+type Event = vortex.Event
+
+// ConstructEvent verifies the signature of a webhook and parses it
+func ConstructEvent(payload []byte, sigHeader, secret string) (Event, error) {
+	return ConstructEventWithTolerance(payload, sigHeader, secret, DefaultTolerance)
+}
+
+// ConstructEventWithTolerance is like ConstructEvent with a custom tolerance
+// for the signature's age; zero or less disables the check
+func ConstructEventWithTolerance(payload []byte, sigHeader, secret string, tolerance time.Duration) (Event, error) {
+	if err := VerifySignature(payload, sigHeader, secret, tolerance); err != nil {
+		return Event{}, err
+	}
+	return ParseEvent(payload)
+}
+
+// ParseEvent parses a webhook without verifying it, e.g. one that was
+// verified before being queued
+func ParseEvent(payload []byte) (Event, error) {
+	return vortex.ParseEvent(payload)
+}
+
+// VerifySignature checks a signature header against the payload and secret
+func VerifySignature(payload []byte, sigHeader, secret string, tolerance time.Duration) error {
+	timestamp, signatures, err := parseHeader(sigHeader)
+	if err != nil {
+		return err
+	}
+
+	if tolerance > 0 {
+		age := time.Since(time.Unix(timestamp, 0))
+		if age > tolerance || age < -tolerance {
+			return ErrTimestampOutOfRange
+		}
+	}
+
+	expected := computeSignature(payload, secret, timestamp)
+	for _, signature := range signatures {
+		if hmac.Equal(signature, expected) {
+			return nil
+		}
+	}
+	return ErrInvalidSignature
+}
+
+// Sign returns the signature header Vortex would send for payload, for
+// testing webhook handlers
+func Sign(payload []byte, secret string, t time.Time) string {
+	timestamp := t.Unix()
+	signature := hex.EncodeToString(computeSignature(payload, secret, timestamp))
+	return fmt.Sprintf("t=%d,v1=%s", timestamp, signature)
+}
+
+func computeSignature(payload []byte, secret string, timestamp int64) []byte {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10)))
+	mac.Write([]byte("."))
+	mac.Write(payload)
+	return mac.Sum(nil)
+}
+
+func parseHeader(header string) (int64, [][]byte, error) {
+	var timestamp int64
+	var signatures [][]byte
+
+	for _, part := range strings.Split(header, ",") {
+		key, value, ok := strings.Cut(strings.TrimSpace(part), "=")
+		if !ok {
+			continue
+		}
+		switch key {
+		case "t":
+			t, err := strconv.ParseInt(value, 10, 64)
+			if err != nil {
+				return 0, nil, fmt.Errorf("%w: bad timestamp", ErrInvalidHeader)
+			}
+			timestamp = t
+		case "v1":
+			signature, err := hex.DecodeString(value)
+			if err != nil {
+				continue
+			}
+			signatures = append(signatures, signature)
+		}
+	}
+
+	if timestamp == 0 {
+		return 0, nil, fmt.Errorf("%w: no timestamp", ErrInvalidHeader)
+	}
+	if len(signatures) == 0 {
+		return 0, nil, fmt.Errorf("%w: no v1 signature", ErrInvalidHeader)
+	}
+	return timestamp, signatures, nil
+}
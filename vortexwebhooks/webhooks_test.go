@@ -0,0 +1,214 @@
+package vortexwebhooks
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	vortex "github.com/TeamVortexSoftware/vortex-go-sdk"
+)
+
+const testSecret = "whsec_test"
+
+const acceptedPayload = `{"id":"evt-1","type":"invitation.accepted","createdAt":"2024-01-01T00:00:00Z",` +
+	`"data":{"invitation":{"id":"inv-1","status":"accepted"},"actor":{"id":"user-1"},"occurredAt":"2024-01-01T00:00:00Z"}}`
+
+func TestConstructEvent(t *testing.T) {
+	payload := []byte(acceptedPayload)
+	header := Sign(payload, testSecret, time.Now())
+
+	event, err := ConstructEvent(payload, header, testSecret)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if event.ID != "evt-1" || event.Type != vortex.EventInvitationAccepted {
+		t.Errorf("Unexpected event %+v", event)
+	}
+
+	data, ok := event.Data.(*vortex.InvitationEvent)
+	if !ok {
+		t.Fatalf("Expected *vortex.InvitationEvent, got %T", event.Data)
+	}
+	if data.Invitation.ID != "inv-1" {
+		t.Errorf("Expected invitation inv-1, got %s", data.Invitation.ID)
+	}
+	if data.Actor == nil || data.Actor.ID != "user-1" {
+		t.Errorf("Expected actor user-1, got %+v", data.Actor)
+	}
+}
+
+func TestConstructEventTypedPayloads(t *testing.T) {
+	tests := []struct {
+		payload string
+		want    interface{}
+	}{
+		{`{"id":"evt","type":"invitation.revoked","data":{}}`, &vortex.InvitationEvent{}},
+		{`{"id":"evt","type":"invitation.approved","data":{}}`, &vortex.InvitationReviewEvent{}},
+		{`{"id":"evt","type":"delivery.bounced","data":{"bounceType":"hard"}}`, &vortex.DeliveryBouncedEvent{}},
+		{`{"id":"evt","type":"delivery.complained","data":{}}`, &vortex.DeliveryComplainedEvent{}},
+	}
+
+	for _, tt := range tests {
+		event, err := ConstructEvent([]byte(tt.payload), Sign([]byte(tt.payload), testSecret, time.Now()), testSecret)
+		if err != nil {
+			t.Fatalf("Expected no error for %s, got %v", tt.payload, err)
+		}
+		if got, want := fmt.Sprintf("%T", event.Data), fmt.Sprintf("%T", tt.want); got != want {
+			t.Errorf("Expected %s for %s, got %s", want, event.Type, got)
+		}
+	}
+}
+
+func TestConstructEventUnknownType(t *testing.T) {
+	payload := []byte(`{"id":"evt-2","type":"invitation.teleported","data":{"x":1}}`)
+
+	event, err := ConstructEvent(payload, Sign(payload, testSecret, time.Now()), testSecret)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if event.Data != nil {
+		t.Errorf("Expected nil Data for unknown type, got %T", event.Data)
+	}
+	if string(event.RawData) != `{"x":1}` {
+		t.Errorf("Expected raw data to be kept, got %s", event.RawData)
+	}
+}
+
+func TestConstructEventInvalidSignature(t *testing.T) {
+	payload := []byte(acceptedPayload)
+
+	header := Sign(payload, "wrong-secret", time.Now())
+	if _, err := ConstructEvent(payload, header, testSecret); !errors.Is(err, ErrInvalidSignature) {
+		t.Errorf("Expected ErrInvalidSignature, got %v", err)
+	}
+
+	header = Sign(payload, testSecret, time.Now())
+	tampered := []byte(strings.Replace(acceptedPayload, "inv-1", "inv-2", 1))
+	if _, err := ConstructEvent(tampered, header, testSecret); !errors.Is(err, ErrInvalidSignature) {
+		t.Errorf("Expected ErrInvalidSignature for tampered payload, got %v", err)
+	}
+}
+
+func TestConstructEventInvalidHeader(t *testing.T) {
+	for _, header := range []string{"", "v1=abcd", "t=abc,v1=abcd", "t=1700000000"} {
+		if _, err := ConstructEvent([]byte(acceptedPayload), header, testSecret); !errors.Is(err, ErrInvalidHeader) {
+			t.Errorf("Expected ErrInvalidHeader for %q, got %v", header, err)
+		}
+	}
+}
+
+func TestConstructEventTolerance(t *testing.T) {
+	payload := []byte(acceptedPayload)
+	header := Sign(payload, testSecret, time.Now().Add(-10*time.Minute))
+
+	if _, err := ConstructEvent(payload, header, testSecret); !errors.Is(err, ErrTimestampOutOfRange) {
+		t.Errorf("Expected ErrTimestampOutOfRange, got %v", err)
+	}
+	if _, err := ConstructEventWithTolerance(payload, header, testSecret, time.Hour); err != nil {
+		t.Errorf("Expected no error with a longer tolerance, got %v", err)
+	}
+	if _, err := ConstructEventWithTolerance(payload, header, testSecret, 0); err != nil {
+		t.Errorf("Expected no error with the check disabled, got %v", err)
+	}
+}
+
+func TestConstructEventSecretRotation(t *testing.T) {
+	payload := []byte(acceptedPayload)
+	now := time.Now()
+	oldHeader := Sign(payload, "old-secret", now)
+	newHeader := Sign(payload, testSecret, now)
+	header := oldHeader + "," + newHeader[strings.Index(newHeader, "v1="):]
+
+	if _, err := ConstructEvent(payload, header, testSecret); err != nil {
+		t.Errorf("Expected new secret to verify, got %v", err)
+	}
+	if _, err := ConstructEvent(payload, header, "old-secret"); err != nil {
+		t.Errorf("Expected old secret to verify, got %v", err)
+	}
+}
+
+func newWebhookRequest(payload string, secret string) *http.Request {
+	req := httptest.NewRequest(http.MethodPost, "/webhooks", strings.NewReader(payload))
+	req.Header.Set(SignatureHeader, Sign([]byte(payload), secret, time.Now()))
+	return req
+}
+
+func TestHandler(t *testing.T) {
+	var handled []string
+	handler := &Handler{
+		Secret: testSecret,
+		Dedupe: NewMemoryDedupe(),
+		Handle: func(ctx context.Context, event Event) error {
+			handled = append(handled, event.ID)
+			return nil
+		},
+	}
+
+	for i := 0; i < 2; i++ {
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, newWebhookRequest(acceptedPayload, testSecret))
+		if rec.Code != http.StatusOK {
+			t.Fatalf("Expected status 200, got %d", rec.Code)
+		}
+	}
+	if len(handled) != 1 {
+		t.Errorf("Expected redelivered event to be skipped, handled %v", handled)
+	}
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, newWebhookRequest(acceptedPayload, "wrong-secret"))
+	if rec.Code != http.StatusBadRequest {
+		t.Errorf("Expected status 400, got %d", rec.Code)
+	}
+}
+
+func TestHandlerReleasesFailedEvents(t *testing.T) {
+	attempts := 0
+	handler := &Handler{
+		Secret: testSecret,
+		Dedupe: NewMemoryDedupe(),
+		Handle: func(ctx context.Context, event Event) error {
+			attempts++
+			if attempts == 1 {
+				return errors.New("database unavailable")
+			}
+			return nil
+		},
+	}
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, newWebhookRequest(acceptedPayload, testSecret))
+	if rec.Code != http.StatusInternalServerError {
+		t.Fatalf("Expected status 500, got %d", rec.Code)
+	}
+
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, newWebhookRequest(acceptedPayload, testSecret))
+	if rec.Code != http.StatusOK {
+		t.Errorf("Expected status 200, got %d", rec.Code)
+	}
+	if attempts != 2 {
+		t.Errorf("Expected redelivery to be handled, got %d attempts", attempts)
+	}
+}
+
+func TestMemoryDedupeExpires(t *testing.T) {
+	dedupe := NewMemoryDedupe()
+	ctx := context.Background()
+
+	if isNew, _ := dedupe.Claim(ctx, "evt-1", time.Millisecond); !isNew {
+		t.Fatal("Expected first claim to be new")
+	}
+	time.Sleep(5 * time.Millisecond)
+	if isNew, _ := dedupe.Claim(ctx, "evt-1", time.Hour); !isNew {
+		t.Error("Expected expired claim to be new")
+	}
+	if isNew, _ := dedupe.Claim(ctx, "evt-1", time.Hour); isNew {
+		t.Error("Expected second claim to be a duplicate")
+	}
+}
@@ -0,0 +1,16 @@
+module github.com/TeamVortexSoftware/vortex-go-sdk/vortexzap
+
+go 1.19
+
+require (
+	github.com/TeamVortexSoftware/vortex-go-sdk v1.2.0
+	go.uber.org/zap v1.27.0
+)
+
+require (
+	github.com/google/uuid v1.6.0 // indirect
+	github.com/stretchr/testify v1.8.4 // indirect
+	go.uber.org/multierr v1.10.0 // indirect
+)
+
+replace github.com/TeamVortexSoftware/vortex-go-sdk => ../
@@ -0,0 +1,41 @@
+// Package vortexzap adapts a zap logger to vortex.Logger.
+//
+//	client := vortex.NewClient(apiKey, vortex.WithLogger(vortexzap.New(logger)))
+package vortexzap
+
+import (
+	vortex "github.com/TeamVortexSoftware/vortex-go-sdk"
+	"go.uber.org/zap"
+)
+
+// Logger is a vortex.Logger writing to a zap logger
+type Logger struct {
+	sugar *zap.SugaredLogger
+}
+
+var _ vortex.Logger = (*Logger)(nil)
+
+// New creates a vortex.Logger writing to logger
+func New(logger *zap.Logger) *Logger {
+	return &Logger{sugar: logger.Sugar()}
+}
+
+// Debug implements vortex.Logger
+func (l *Logger) Debug(msg string, keysAndValues ...interface{}) {
+	l.sugar.Debugw(msg, keysAndValues...)
+}
+
+// Info implements vortex.Logger
+func (l *Logger) Info(msg string, keysAndValues ...interface{}) {
+	l.sugar.Infow(msg, keysAndValues...)
+}
+
+// Warn implements vortex.Logger
+func (l *Logger) Warn(msg string, keysAndValues ...interface{}) {
+	l.sugar.Warnw(msg, keysAndValues...)
+}
+
+// Error implements vortex.Logger
+func (l *Logger) Error(msg string, keysAndValues ...interface{}) {
+	l.sugar.Errorw(msg, keysAndValues...)
+}
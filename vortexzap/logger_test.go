@@ -0,0 +1,31 @@
+package vortexzap
+
+import (
+	"testing"
+
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+)
+
+func TestLogger(t *testing.T) {
+	core, logs := observer.New(zapcore.DebugLevel)
+	logger := New(zap.New(core))
+
+	logger.Debug("vortex request", "method", "GET", "path", "/api/v1/invitations")
+	logger.Error("vortex request failed", "method", "DELETE")
+
+	entries := logs.All()
+	if len(entries) != 2 {
+		t.Fatalf("Expected 2 log entries, got %d", len(entries))
+	}
+	if entries[0].Level != zapcore.DebugLevel || entries[0].Message != "vortex request" {
+		t.Errorf("Expected debug 'vortex request', got %s %q", entries[0].Level, entries[0].Message)
+	}
+	if path := entries[0].ContextMap()["path"]; path != "/api/v1/invitations" {
+		t.Errorf("Expected path field '/api/v1/invitations', got %v", path)
+	}
+	if entries[1].Level != zapcore.ErrorLevel {
+		t.Errorf("Expected error level, got %s", entries[1].Level)
+	}
+}
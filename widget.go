@@ -0,0 +1,49 @@
+package vortex
+
+import (
+	"context"
+	"fmt"
+)
+
+// WidgetBootstrap is everything the embedded widget needs to render
+//
+// It serializes to JSON so server-rendered pages can embed it for the widget
+// to hydrate from.
+type WidgetBootstrap struct {
+	Token              string                 `json:"token"`
+	Configuration      map[string]interface{} `json:"configuration"`
+	PendingInvitations []InvitationResult     `json:"pendingInvitations"`
+}
+
+// GetWidgetBootstrap returns a JWT for the user together with the widget
+// configuration and the group's pending invitations, in a single API call
+//
+// Example:
+//
+//	bootstrap, err := client.GetWidgetBootstrap(ctx, user, vortex.GroupRef{Type: "workspace", GroupID: "ws-123"})
+//	data, _ := json.Marshal(bootstrap)
+//	// render data into the page for the widget
+func (c *Client) GetWidgetBootstrap(ctx context.Context, user *User, group GroupRef, opts ...RequestOption) (*WidgetBootstrap, error) {
+	token, err := c.tokens.Token(user)
+	if err != nil {
+		return nil, err
+	}
+
+	queryParams := map[string]string{
+		"groupType": group.Type,
+		"groupId":   group.GroupID,
+	}
+
+	responseBody, err := c.AsUser(user).apiRequestContext(ctx, "GET", "/api/v1/widget/bootstrap", nil, queryParams, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	var bootstrap WidgetBootstrap
+	if err := c.decode(responseBody, &bootstrap); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+	bootstrap.Token = token
+
+	return &bootstrap, nil
+}
@@ -0,0 +1,50 @@
+package vortex
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestGetWidgetBootstrap(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "GET" || r.URL.Path != "/api/v1/widget/bootstrap" {
+			t.Errorf("Expected GET /api/v1/widget/bootstrap, got %s %s", r.Method, r.URL.Path)
+		}
+		if r.URL.Query().Get("groupType") != "workspace" || r.URL.Query().Get("groupId") != "ws-123" {
+			t.Errorf("Expected group query parameters, got %s", r.URL.RawQuery)
+		}
+		if r.Header.Get("Authorization") == "" {
+			t.Error("Expected the user's token on the request")
+		}
+
+		w.Write([]byte(`{
+			"configuration": {"theme": "dark"},
+			"pendingInvitations": [{"id": "inv-1", "status": "pending"}]
+		}`))
+	}))
+	defer server.Close()
+
+	client := NewClientWithOptions("VRTX.EjRWeBI0EjQSNBI0VniQEg.test-key", server.URL, nil)
+	user := &User{ID: "user-123", Email: "test@example.com"}
+
+	bootstrap, err := client.GetWidgetBootstrap(context.Background(), user, GroupRef{Type: "workspace", GroupID: "ws-123"})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	claims, err := client.VerifyJWT(bootstrap.Token)
+	if err != nil {
+		t.Fatalf("Expected a valid token, got %v", err)
+	}
+	if claims.UserID != "user-123" {
+		t.Errorf("Expected token for 'user-123', got %s", claims.UserID)
+	}
+	if bootstrap.Configuration["theme"] != "dark" {
+		t.Errorf("Expected configuration theme 'dark', got %v", bootstrap.Configuration["theme"])
+	}
+	if len(bootstrap.PendingInvitations) != 1 || bootstrap.PendingInvitations[0].ID != "inv-1" {
+		t.Errorf("Expected 1 pending invitation, got %+v", bootstrap.PendingInvitations)
+	}
+}